@@ -0,0 +1,279 @@
+// Package archive implements a portable file format to export and import a
+// range of a randomness chain.
+//
+// An archive starts with a single JSON line describing its content, including
+// the chain info needed to verify the beacons. It is followed by the beacons
+// in increasing round order, encoded as BeaconPackets either one JSON object
+// per line (NDJSON) or as protobuf messages prefixed by their length as an
+// uvarint.
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	json "github.com/nikkolasg/hexjson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/drand/drand/chain"
+	"github.com/drand/drand/chain/beacon"
+	chainerrors "github.com/drand/drand/chain/errors"
+	"github.com/drand/drand/protobuf/drand"
+)
+
+// Encoding is the encoding of the beacons following the header of an archive
+type Encoding string
+
+const (
+	// NDJSON encodes each beacon as a JSON object on its own line
+	NDJSON Encoding = "ndjson"
+	// Protobuf encodes each beacon as a length-prefixed protobuf message
+	Protobuf Encoding = "protobuf"
+)
+
+// formatVersion is the version of the archive format written by this package
+const formatVersion = 1
+
+// maxPacketSize bounds the size of a protobuf encoded beacon we accept to read
+const maxPacketSize = 1 << 16
+
+type header struct {
+	Version  int                    `json:"version"`
+	Encoding Encoding               `json:"encoding"`
+	Info     *drand.ChainInfoPacket `json:"info"`
+}
+
+// Writer writes beacons to an archive
+type Writer struct {
+	w   *bufio.Writer
+	enc Encoding
+	buf []byte
+}
+
+// NewWriter writes the header of an archive of the chain described by info to
+// w and returns a Writer to append beacons to it.
+func NewWriter(w io.Writer, info *chain.Info, enc Encoding) (*Writer, error) {
+	if enc != NDJSON && enc != Protobuf {
+		return nil, fmt.Errorf("unknown archive encoding %q", enc)
+	}
+
+	bw := bufio.NewWriter(w)
+	h := &header{
+		Version:  formatVersion,
+		Encoding: enc,
+		Info:     info.ToProto(nil),
+	}
+	// the JSON encoder terminates the header with a new line
+	if err := json.NewEncoder(bw).Encode(h); err != nil {
+		return nil, fmt.Errorf("unable to write archive header: %w", err)
+	}
+	return &Writer{w: bw, enc: enc}, nil
+}
+
+// Write appends a beacon to the archive
+func (w *Writer) Write(b *chain.Beacon) error {
+	packet := &drand.BeaconPacket{
+		PreviousSig: b.PreviousSig,
+		Round:       b.Round,
+		Signature:   b.Signature,
+	}
+
+	if w.enc == NDJSON {
+		return json.NewEncoder(w.w).Encode(packet)
+	}
+
+	buff, err := proto.Marshal(packet)
+	if err != nil {
+		return err
+	}
+	w.buf = binary.AppendUvarint(w.buf[:0], uint64(len(buff)))
+	if _, err := w.w.Write(w.buf); err != nil {
+		return err
+	}
+	_, err = w.w.Write(buff)
+	return err
+}
+
+// Flush writes any buffered data to the underlying writer
+func (w *Writer) Flush() error {
+	return w.w.Flush()
+}
+
+// Reader reads the beacons of an archive
+type Reader struct {
+	r    *bufio.Reader
+	enc  Encoding
+	info *chain.Info
+}
+
+// NewReader reads the header of the archive in r
+func NewReader(r io.Reader) (*Reader, error) {
+	br := bufio.NewReader(r)
+	line, err := br.ReadBytes('\n')
+	if err != nil {
+		return nil, fmt.Errorf("unable to read archive header: %w", err)
+	}
+
+	h := new(header)
+	if err := json.Unmarshal(line, h); err != nil {
+		return nil, fmt.Errorf("invalid archive header: %w", err)
+	}
+	if h.Version != formatVersion {
+		return nil, fmt.Errorf("unsupported archive version %d", h.Version)
+	}
+	if h.Encoding != NDJSON && h.Encoding != Protobuf {
+		return nil, fmt.Errorf("unknown archive encoding %q", h.Encoding)
+	}
+	if h.Info == nil {
+		return nil, errors.New("archive header has no chain info")
+	}
+	info, err := chain.InfoFromProto(h.Info)
+	if err != nil {
+		return nil, fmt.Errorf("invalid chain info in archive header: %w", err)
+	}
+	if len(h.Info.Hash) > 0 && !bytes.Equal(h.Info.Hash, info.Hash()) {
+		return nil, errors.New("chain info in archive header doesn't match its hash")
+	}
+
+	return &Reader{r: br, enc: h.Encoding, info: info}, nil
+}
+
+// Info returns the chain info embedded in the archive
+func (r *Reader) Info() *chain.Info {
+	return r.info
+}
+
+// Encoding returns the encoding of the beacons in the archive
+func (r *Reader) Encoding() Encoding {
+	return r.enc
+}
+
+// Next returns the next beacon of the archive. It returns io.EOF once all the
+// beacons have been read.
+func (r *Reader) Next() (*chain.Beacon, error) {
+	packet := new(drand.BeaconPacket)
+
+	if r.enc == NDJSON {
+		line, err := r.r.ReadBytes('\n')
+		if errors.Is(err, io.EOF) && len(bytes.TrimSpace(line)) == 0 {
+			return nil, io.EOF
+		}
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		if err := json.Unmarshal(line, packet); err != nil {
+			return nil, fmt.Errorf("invalid beacon in archive: %w", err)
+		}
+	} else {
+		size, err := binary.ReadUvarint(r.r)
+		if err != nil {
+			// a clean EOF only happens between two beacons
+			return nil, err
+		}
+		if size > maxPacketSize {
+			return nil, fmt.Errorf("beacon of %d bytes in archive is too large", size)
+		}
+		buff := make([]byte, size)
+		if _, err := io.ReadFull(r.r, buff); err != nil {
+			return nil, fmt.Errorf("truncated beacon in archive: %w", err)
+		}
+		if err := proto.Unmarshal(buff, packet); err != nil {
+			return nil, fmt.Errorf("invalid beacon in archive: %w", err)
+		}
+	}
+
+	return &chain.Beacon{
+		PreviousSig: packet.GetPreviousSig(),
+		Round:       packet.GetRound(),
+		Signature:   packet.GetSignature(),
+	}, nil
+}
+
+// Export writes the beacons of the store from round "from" up to round "to"
+// (both inclusive) to w. A "to" of 0 exports up to the last beacon. The genesis
+// beacon is never exported since it is derived from the chain info. It returns
+// the number of beacons written.
+func Export(ctx context.Context, store chain.Store, w *Writer, from, to uint64) (int, error) {
+	if from == 0 {
+		from = 1
+	}
+
+	written := 0
+	err := store.Cursor(ctx, func(ctx context.Context, c chain.Cursor) error {
+		b, err := c.Seek(ctx, from)
+		for ; b != nil; b, err = c.Next(ctx) {
+			if err != nil {
+				return err
+			}
+			if to != 0 && b.Round > to {
+				return nil
+			}
+			if err := w.Write(b); err != nil {
+				return fmt.Errorf("unable to write round %d: %w", b.Round, err)
+			}
+			written++
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+		return err
+	})
+	// the cursor always ends with ErrNoBeaconStored
+	if err != nil && !errors.Is(err, chainerrors.ErrNoBeaconStored) {
+		return written, err
+	}
+
+	return written, w.Flush()
+}
+
+// Import verifies every beacon of the archive against its chain info and
+// writes them to the store. The store must be empty or hold the same chain.
+// For chained schemes, the beacon preceding the first imported round must be
+// in the store or in the archive. It returns the number of beacons imported.
+func Import(ctx context.Context, store chain.Store, r *Reader) (int, error) {
+	info := r.Info()
+
+	genesis := chain.GenesisBeacon(info)
+	stored, err := store.Get(ctx, 0)
+	switch {
+	case errors.Is(err, chainerrors.ErrNoBeaconStored):
+		if err := store.Put(ctx, genesis); err != nil {
+			return 0, fmt.Errorf("unable to insert genesis beacon: %w", err)
+		}
+	case err != nil:
+		return 0, fmt.Errorf("unable to read genesis beacon: %w", err)
+	case !stored.Equal(genesis):
+		return 0, errors.New("the archive belongs to a different chain than the store")
+	}
+
+	verifier := chain.NewVerifier(info.Scheme)
+	ss := beacon.NewSchemeStore(store, info.Scheme)
+
+	imported := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return imported, err
+		}
+
+		b, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return imported, nil
+		}
+		if err != nil {
+			return imported, err
+		}
+
+		if err := verifier.VerifyBeacon(*b, info.PublicKey); err != nil {
+			return imported, fmt.Errorf("invalid beacon for round %d: %w", b.Round, err)
+		}
+		if err := ss.Put(ctx, b); err != nil {
+			return imported, fmt.Errorf("unable to store round %d: %w", b.Round, err)
+		}
+		imported++
+	}
+}
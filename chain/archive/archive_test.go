@@ -0,0 +1,155 @@
+package archive
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/chain"
+	"github.com/drand/drand/chain/memdb"
+	"github.com/drand/drand/common/scheme"
+	"github.com/drand/drand/key"
+	"github.com/drand/drand/test"
+	"github.com/drand/kyber/share"
+	"github.com/drand/kyber/util/random"
+)
+
+// newTestChain returns the info of a new chain and a store holding its first n
+// beacons
+func newTestChain(t *testing.T, n uint64) (*chain.Info, chain.Store) {
+	t.Helper()
+	ctx := context.Background()
+	sch := scheme.GetSchemeFromEnv()
+	c := key.CryptoFor(sch)
+	secret := c.KeyGroup.Scalar().Pick(random.New())
+	seed := make([]byte, 32)
+	random.Bytes(seed, random.New())
+	info := &chain.Info{
+		PublicKey:   c.KeyGroup.Point().Mul(secret, nil),
+		Period:      time.Second,
+		Scheme:      sch,
+		GenesisTime: time.Now().Unix(),
+		GenesisSeed: seed,
+	}
+
+	store := memdb.NewStore(test.Logger(t), int(n)+1)
+	prev := chain.GenesisBeacon(info)
+	require.NoError(t, store.Put(ctx, prev))
+
+	verifier := chain.NewVerifier(sch)
+	for i := uint64(1); i <= n; i++ {
+		b := &chain.Beacon{Round: i}
+		if !sch.DecouplePrevSig {
+			b.PreviousSig = prev.Signature
+		}
+		// the full signature is the partial of the only share of the secret,
+		// without its index
+		tsig, err := c.ThresholdScheme.Sign(&share.PriShare{V: secret}, verifier.DigestMessage(i, b.PreviousSig))
+		require.NoError(t, err)
+		b.Signature = tsig[2:]
+		require.NoError(t, store.Put(ctx, b))
+		prev = b
+	}
+	return info, store
+}
+
+func TestArchiveRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	info, src := newTestChain(t, 10)
+
+	for _, enc := range []Encoding{NDJSON, Protobuf} {
+		t.Run(string(enc), func(t *testing.T) {
+			var buff bytes.Buffer
+			w, err := NewWriter(&buff, info, enc)
+			require.NoError(t, err)
+			n, err := Export(ctx, src, w, 0, 0)
+			require.NoError(t, err)
+			require.Equal(t, 10, n)
+
+			r, err := NewReader(&buff)
+			require.NoError(t, err)
+			require.Equal(t, enc, r.Encoding())
+			require.True(t, info.Equal(r.Info()))
+
+			dst := memdb.NewStore(test.Logger(t), 20)
+			n, err = Import(ctx, dst, r)
+			require.NoError(t, err)
+			require.Equal(t, 10, n)
+
+			for i := uint64(0); i <= 10; i++ {
+				expected, err := src.Get(ctx, i)
+				require.NoError(t, err)
+				got, err := dst.Get(ctx, i)
+				require.NoError(t, err)
+				require.True(t, expected.Equal(got), "round %d", i)
+			}
+		})
+	}
+}
+
+func TestArchiveRange(t *testing.T) {
+	ctx := context.Background()
+	info, src := newTestChain(t, 10)
+
+	var buff bytes.Buffer
+	w, err := NewWriter(&buff, info, Protobuf)
+	require.NoError(t, err)
+	n, err := Export(ctx, src, w, 3, 8)
+	require.NoError(t, err)
+	require.Equal(t, 6, n)
+
+	r, err := NewReader(&buff)
+	require.NoError(t, err)
+	for i := uint64(3); i <= 8; i++ {
+		b, err := r.Next()
+		require.NoError(t, err)
+		require.Equal(t, i, b.Round)
+	}
+	_, err = r.Next()
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestArchiveImportInvalid(t *testing.T) {
+	ctx := context.Background()
+	info, src := newTestChain(t, 5)
+
+	// a beacon with a bad signature stops the import
+	var buff bytes.Buffer
+	w, err := NewWriter(&buff, info, NDJSON)
+	require.NoError(t, err)
+	for i := uint64(1); i <= 5; i++ {
+		b, err := src.Get(ctx, i)
+		require.NoError(t, err)
+		if i == 4 {
+			b = &chain.Beacon{Round: b.Round, PreviousSig: b.PreviousSig, Signature: []byte("not a signature")}
+		}
+		require.NoError(t, w.Write(b))
+	}
+	require.NoError(t, w.Flush())
+
+	r, err := NewReader(&buff)
+	require.NoError(t, err)
+	dst := memdb.NewStore(test.Logger(t), 10)
+	n, err := Import(ctx, dst, r)
+	require.Error(t, err)
+	require.Equal(t, 3, n)
+	last, err := dst.Last(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), last.Round)
+
+	// an archive of another chain is refused
+	otherInfo, other := newTestChain(t, 2)
+	buff.Reset()
+	w, err = NewWriter(&buff, otherInfo, NDJSON)
+	require.NoError(t, err)
+	_, err = Export(ctx, other, w, 0, 0)
+	require.NoError(t, err)
+	r, err = NewReader(&buff)
+	require.NoError(t, err)
+	_, err = Import(ctx, src, r)
+	require.Error(t, err)
+}
@@ -0,0 +1,104 @@
+package badgerdb
+
+import (
+	"context"
+
+	"github.com/dgraph-io/badger/v2"
+
+	"github.com/drand/drand/chain"
+	chainerrors "github.com/drand/drand/chain/errors"
+)
+
+// badgerCursor moves over the beacons of a transaction. Badger only allows a
+// single iterator at a time in a read-write transaction and iterators only go
+// in one direction, so the cursor replaces its iterator when Last is called.
+type badgerCursor struct {
+	txn     *badger.Txn
+	it      *badger.Iterator
+	reverse bool
+}
+
+func newBadgerCursor(txn *badger.Txn) *badgerCursor {
+	return &badgerCursor{txn: txn}
+}
+
+func (c *badgerCursor) close() {
+	if c.it != nil {
+		c.it.Close()
+		c.it = nil
+	}
+}
+
+// iterator returns an iterator going in the given direction
+func (c *badgerCursor) iterator(reverse bool) *badger.Iterator {
+	if c.it != nil && c.reverse == reverse {
+		return c.it
+	}
+	c.close()
+	c.it = c.txn.NewIterator(badger.IteratorOptions{
+		PrefetchValues: !reverse,
+		PrefetchSize:   100,
+		Reverse:        reverse,
+		Prefix:         beaconPrefix,
+	})
+	c.reverse = reverse
+	return c.it
+}
+
+func (c *badgerCursor) current() (*chain.Beacon, error) {
+	if c.it == nil || !c.it.Valid() {
+		return nil, chainerrors.ErrNoBeaconStored
+	}
+	return decodeItem(c.it.Item())
+}
+
+func (c *badgerCursor) First(context.Context) (*chain.Beacon, error) {
+	c.iterator(false).Seek(beaconPrefix)
+	return c.current()
+}
+
+func (c *badgerCursor) Next(ctx context.Context) (*chain.Beacon, error) {
+	switch {
+	case c.it == nil:
+		return c.First(ctx)
+	case !c.it.Valid():
+		return nil, chainerrors.ErrNoBeaconStored
+	case c.reverse:
+		// the cursor is on the last beacon, there is nothing after it
+		c.close()
+		return nil, chainerrors.ErrNoBeaconStored
+	}
+	c.it.Next()
+	return c.current()
+}
+
+func (c *badgerCursor) Seek(_ context.Context, round uint64) (*chain.Beacon, error) {
+	c.iterator(false).Seek(roundKey(round))
+	return c.current()
+}
+
+func (c *badgerCursor) Last(context.Context) (*chain.Beacon, error) {
+	// a reverse iterator seeks to the first key lower or equal to the one given
+	c.iterator(true).Seek(roundKey(^uint64(0)))
+	return c.current()
+}
+
+// seekKey returns the first round stored from the given key
+func (c *badgerCursor) seekKey(key []byte) (uint64, error) {
+	it := c.iterator(false)
+	it.Seek(key)
+	if !it.Valid() {
+		return 0, chainerrors.ErrNoBeaconStored
+	}
+	return keyRound(it.Item().Key()), nil
+}
+
+// lastKey returns the last round stored
+func (c *badgerCursor) lastKey() (uint64, error) {
+	it := c.iterator(true)
+	it.Seek(roundKey(^uint64(0)))
+	if !it.Valid() {
+		return 0, chainerrors.ErrNoBeaconStored
+	}
+	return keyRound(it.Item().Key()), nil
+}
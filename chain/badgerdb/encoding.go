@@ -0,0 +1,58 @@
+package badgerdb
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v2"
+
+	"github.com/drand/drand/chain"
+)
+
+// Beacons are stored with the following layout, the round being the key:
+//
+//	signature length (2 bytes) | signature | previous signature
+//
+// The length is big-endian and the previous signature takes the rest of the
+// value, it is empty for unchained schemes.
+const beaconHeaderLen = 2
+
+// errInvalidBeaconValue is returned when a stored value can't be decoded
+var errInvalidBeaconValue = errors.New("invalid beacon value in database")
+
+func encodeBeacon(b *chain.Beacon) ([]byte, error) {
+	if len(b.Signature) > 0xFFFF {
+		return nil, fmt.Errorf("signature of round %d is too long: %d bytes", b.Round, len(b.Signature))
+	}
+
+	buff := make([]byte, beaconHeaderLen, beaconHeaderLen+len(b.Signature)+len(b.PreviousSig))
+	binary.BigEndian.PutUint16(buff, uint16(len(b.Signature)))
+	buff = append(buff, b.Signature...)
+	buff = append(buff, b.PreviousSig...)
+	return buff, nil
+}
+
+// decodeItem reads the beacon stored in the item
+func decodeItem(item *badger.Item) (*chain.Beacon, error) {
+	b := &chain.Beacon{Round: keyRound(item.Key())}
+	err := item.Value(func(v []byte) error {
+		if len(v) < beaconHeaderLen {
+			return errInvalidBeaconValue
+		}
+		sigLen := int(binary.BigEndian.Uint16(v))
+		if len(v) < beaconHeaderLen+sigLen {
+			return errInvalidBeaconValue
+		}
+		// the value is only valid during the transaction, so we copy it out
+		b.Signature = append([]byte(nil), v[beaconHeaderLen:beaconHeaderLen+sigLen]...)
+		if prev := v[beaconHeaderLen+sigLen:]; len(prev) > 0 {
+			b.PreviousSig = append([]byte(nil), prev...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
@@ -0,0 +1,448 @@
+package badgerdb
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/badger/v2"
+
+	"github.com/drand/drand/chain"
+	"github.com/drand/drand/chain/boltdb"
+	chainerrors "github.com/drand/drand/chain/errors"
+	"github.com/drand/drand/log"
+)
+
+// BadgerStore implements the Store interface using the Badger LSM-tree key
+// value store. Beacons are keyed by their round so that iterating over the keys
+// follows the chain, and like the BoltStore it keeps the number of beacons and
+// the range of rounds they span up to date along with them.
+//
+//nolint:gocritic// We do want to have a mutex here
+type BadgerStore struct {
+	// serializes the writes, so that updating the metadata never conflicts
+	sync.Mutex
+	db *badger.DB
+
+	log  log.Logger
+	done chan bool
+	wg   sync.WaitGroup
+
+	// Close can be called more than once, only the first call closes the db
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// BadgerFolderName is the name of the folder badger writes to
+const BadgerFolderName = "badger"
+
+// how often the value log is garbage collected
+var gcInterval = 10 * time.Minute
+
+// the fraction of a value log file that must be discardable to rewrite it
+const gcDiscardRatio = 0.5
+
+var (
+	beaconPrefix       = []byte("b/")
+	contributorsPrefix = []byte("c/")
+	lenKey             = []byte("m/len")
+	firstKey           = []byte("m/first")
+	lastKey            = []byte("m/last")
+)
+
+// NewBadgerStore returns a Store implementation using the badger storage
+// engine, writing in the BadgerFolderName folder of the given folder.
+func NewBadgerStore(l log.Logger, folder string) (*BadgerStore, error) {
+	opts := badger.DefaultOptions(path.Join(folder, BadgerFolderName)).
+		WithLogger(&badgerLogger{l: l})
+	db, err := badger.Open(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &BadgerStore{
+		db:   db,
+		log:  l,
+		done: make(chan bool),
+	}
+	b.wg.Add(1)
+	go b.runGC()
+	return b, nil
+}
+
+// Len returns the number of beacons stored, genesis included
+func (b *BadgerStore) Len(context.Context) (int, error) {
+	var length uint64
+	err := b.db.View(func(txn *badger.Txn) error {
+		var err error
+		length, err = readMeta(txn, lenKey)
+		return err
+	})
+	if err != nil {
+		b.log.Warnw("", "badgerdb", "error getting length", "err", err)
+	}
+	return int(length), err
+}
+
+// Range returns the lowest and highest rounds stored after genesis
+func (b *BadgerStore) Range(context.Context) (first, last uint64, err error) {
+	err = b.db.View(func(txn *badger.Txn) error {
+		first, last, err = readRange(txn)
+		return err
+	})
+	return first, last, err
+}
+
+// Gaps returns the ranges of rounds missing between the rounds returned by
+// Range. It only iterates over the keys when the beacons stored don't already
+// cover the whole range.
+func (b *BadgerStore) Gaps(context.Context) ([]chain.RoundRange, error) {
+	var gaps []chain.RoundRange
+	err := b.db.View(func(txn *badger.Txn) error {
+		first, last, err := readRange(txn)
+		if errors.Is(err, chainerrors.ErrNoBeaconStored) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		stored, err := readMeta(txn, lenKey)
+		if err != nil {
+			return err
+		}
+		if _, err := txn.Get(roundKey(0)); err == nil {
+			stored--
+		}
+		if stored == last-first+1 {
+			return nil
+		}
+
+		// we only look at the keys, the beacons don't need to be read
+		it := txn.NewIterator(badger.IteratorOptions{Prefix: beaconPrefix})
+		defer it.Close()
+		prev := first
+		for it.Seek(roundKey(first + 1)); it.Valid(); it.Next() {
+			round := keyRound(it.Item().Key())
+			if round > prev+1 {
+				gaps = append(gaps, chain.RoundRange{From: prev + 1, To: round - 1})
+			}
+			prev = round
+		}
+		return nil
+	})
+	return gaps, err
+}
+
+func (b *BadgerStore) Close(context.Context) error {
+	b.closeOnce.Do(func() {
+		close(b.done)
+		b.wg.Wait()
+
+		b.closeErr = b.db.Close()
+		if b.closeErr != nil {
+			b.log.Errorw("", "badgerdb", "close", "err", b.closeErr)
+		}
+	})
+	return b.closeErr
+}
+
+// Put implements the Store interface. WARNING: It does NOT verify that this
+// beacon is not already saved in the database or not and will overwrite it.
+func (b *BadgerStore) Put(_ context.Context, beacon *chain.Beacon) error {
+	buff, err := encodeBeacon(beacon)
+	if err != nil {
+		return err
+	}
+
+	b.Lock()
+	defer b.Unlock()
+	return b.db.Update(func(txn *badger.Txn) error {
+		key := roundKey(beacon.Round)
+		_, err := txn.Get(key)
+		existed := err == nil
+		if err != nil && !errors.Is(err, badger.ErrKeyNotFound) {
+			return err
+		}
+		if err := txn.Set(key, buff); err != nil {
+			return err
+		}
+		if existed {
+			return nil
+		}
+		return putMeta(txn, beacon.Round)
+	})
+}
+
+// Last returns the last beacon signature saved into the db
+func (b *BadgerStore) Last(context.Context) (*chain.Beacon, error) {
+	beacon := &chain.Beacon{}
+	err := b.db.View(func(txn *badger.Txn) error {
+		c := newBadgerCursor(txn)
+		defer c.close()
+		var err error
+		beacon, err = c.Last(context.Background())
+		return err
+	})
+	if beacon == nil {
+		beacon = &chain.Beacon{}
+	}
+	return beacon, err
+}
+
+// Get returns the beacon saved at this round
+func (b *BadgerStore) Get(_ context.Context, round uint64) (*chain.Beacon, error) {
+	beacon := &chain.Beacon{}
+	err := b.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(roundKey(round))
+		if errors.Is(err, badger.ErrKeyNotFound) {
+			return chainerrors.ErrNoBeaconStored
+		}
+		if err != nil {
+			return err
+		}
+		beacon, err = decodeItem(item)
+		return err
+	})
+	return beacon, err
+}
+
+func (b *BadgerStore) Del(_ context.Context, round uint64) error {
+	b.Lock()
+	defer b.Unlock()
+	return b.db.Update(func(txn *badger.Txn) error {
+		key := roundKey(round)
+		if _, err := txn.Get(key); errors.Is(err, badger.ErrKeyNotFound) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if err := txn.Delete(key); err != nil {
+			return err
+		}
+		if err := txn.Delete(contributorsKey(round)); err != nil {
+			return err
+		}
+		return delMeta(txn, round)
+	})
+}
+
+// PutContributors stores the contributors of a round
+func (b *BadgerStore) PutContributors(_ context.Context, c *chain.Contributors) error {
+	buff, err := c.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	return b.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(contributorsKey(c.Round), buff)
+	})
+}
+
+// Contributors returns the contributors stored for this round
+func (b *BadgerStore) Contributors(_ context.Context, round uint64) (*chain.Contributors, error) {
+	c := &chain.Contributors{Round: round}
+	err := b.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(contributorsKey(round))
+		if errors.Is(err, badger.ErrKeyNotFound) {
+			return chainerrors.ErrNoContributorsStored
+		}
+		if err != nil {
+			return err
+		}
+		return item.Value(c.UnmarshalBinary)
+	})
+	return c, err
+}
+
+func (b *BadgerStore) Cursor(ctx context.Context, fn func(context.Context, chain.Cursor) error) error {
+	err := b.db.View(func(txn *badger.Txn) error {
+		c := newBadgerCursor(txn)
+		defer c.close()
+		return fn(ctx, c)
+	})
+	if err != nil {
+		b.log.Warnw("", "badgerdb", "error getting cursor", "err", err)
+	}
+	return err
+}
+
+// SaveTo writes all the beacons to w as a bolt database, so that backups taken
+// from a badger node can be restored like any other backup.
+func (b *BadgerStore) SaveTo(ctx context.Context, w io.Writer) error {
+	return boltdb.SaveStoreTo(ctx, b.log, b, w)
+}
+
+// runGC reclaims the space of the value log files holding mostly deleted or
+// overwritten beacons, which badger doesn't do on its own.
+func (b *BadgerStore) runGC() {
+	defer b.wg.Done()
+
+	ticker := time.NewTicker(gcInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-b.done:
+			return
+		}
+		// a successful run means there may be more files to rewrite
+		var err error
+		for err == nil {
+			err = b.db.RunValueLogGC(gcDiscardRatio)
+		}
+		if !errors.Is(err, badger.ErrNoRewrite) {
+			b.log.Warnw("", "badgerdb", "value log gc", "err", err)
+		}
+	}
+}
+
+func roundKey(round uint64) []byte {
+	return append(append(make([]byte, 0, len(beaconPrefix)+8), beaconPrefix...), chain.RoundToBytes(round)...)
+}
+
+func contributorsKey(round uint64) []byte {
+	return append(append(make([]byte, 0, len(contributorsPrefix)+8), contributorsPrefix...), chain.RoundToBytes(round)...)
+}
+
+func keyRound(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key[len(beaconPrefix):])
+}
+
+// readMeta returns the value of a metadata key, 0 if it isn't set
+func readMeta(txn *badger.Txn, key []byte) (uint64, error) {
+	item, err := txn.Get(key)
+	if errors.Is(err, badger.ErrKeyNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	var value uint64
+	err = item.Value(func(v []byte) error {
+		if len(v) != 8 {
+			return fmt.Errorf("invalid metadata value for %s", key)
+		}
+		value = binary.BigEndian.Uint64(v)
+		return nil
+	})
+	return value, err
+}
+
+func writeMeta(txn *badger.Txn, key []byte, value uint64) error {
+	return txn.Set(key, chain.RoundToBytes(value))
+}
+
+// readRange returns the lowest and highest rounds stored after genesis
+func readRange(txn *badger.Txn) (first, last uint64, err error) {
+	if _, err := txn.Get(lastKey); errors.Is(err, badger.ErrKeyNotFound) {
+		return 0, 0, chainerrors.ErrNoBeaconStored
+	}
+	if first, err = readMeta(txn, firstKey); err != nil {
+		return 0, 0, err
+	}
+	last, err = readMeta(txn, lastKey)
+	return first, last, err
+}
+
+// putMeta accounts for a new round stored
+func putMeta(txn *badger.Txn, round uint64) error {
+	length, err := readMeta(txn, lenKey)
+	if err != nil {
+		return err
+	}
+	if err := writeMeta(txn, lenKey, length+1); err != nil {
+		return err
+	}
+	if round == 0 {
+		return nil
+	}
+
+	first, last, err := readRange(txn)
+	empty := errors.Is(err, chainerrors.ErrNoBeaconStored)
+	if err != nil && !empty {
+		return err
+	}
+	if empty || round < first {
+		if err := writeMeta(txn, firstKey, round); err != nil {
+			return err
+		}
+	}
+	if empty || round > last {
+		return writeMeta(txn, lastKey, round)
+	}
+	return nil
+}
+
+// delMeta accounts for a round deleted
+func delMeta(txn *badger.Txn, round uint64) error {
+	length, err := readMeta(txn, lenKey)
+	if err != nil {
+		return err
+	}
+	if length > 0 {
+		if err := writeMeta(txn, lenKey, length-1); err != nil {
+			return err
+		}
+	}
+	if round == 0 {
+		return nil
+	}
+
+	first, last, err := readRange(txn)
+	if err != nil {
+		return err
+	}
+	if round != first && round != last {
+		return nil
+	}
+
+	// we look up the new bounds, seeking doesn't scan the keys
+	c := newBadgerCursor(txn)
+	defer c.close()
+	newFirst, err := c.seekKey(roundKey(1))
+	if errors.Is(err, chainerrors.ErrNoBeaconStored) {
+		if err := txn.Delete(firstKey); err != nil {
+			return err
+		}
+		return txn.Delete(lastKey)
+	}
+	if err != nil {
+		return err
+	}
+	newLast, err := c.lastKey()
+	if err != nil {
+		return err
+	}
+	if err := writeMeta(txn, firstKey, newFirst); err != nil {
+		return err
+	}
+	return writeMeta(txn, lastKey, newLast)
+}
+
+// badgerLogger sends the logs of badger to the drand logger. Badger is quite
+// verbose about its internals, so its informational messages are debug ones.
+type badgerLogger struct {
+	l log.Logger
+}
+
+func (b *badgerLogger) Errorf(format string, args ...interface{}) {
+	b.l.Errorw("", "badgerdb", strings.TrimSpace(fmt.Sprintf(format, args...)))
+}
+
+func (b *badgerLogger) Warningf(format string, args ...interface{}) {
+	b.l.Warnw("", "badgerdb", strings.TrimSpace(fmt.Sprintf(format, args...)))
+}
+
+func (b *badgerLogger) Infof(format string, args ...interface{}) {
+	b.l.Debugw("", "badgerdb", strings.TrimSpace(fmt.Sprintf(format, args...)))
+}
+
+func (b *badgerLogger) Debugf(format string, args ...interface{}) {
+	b.l.Debugw("", "badgerdb", strings.TrimSpace(fmt.Sprintf(format, args...)))
+}
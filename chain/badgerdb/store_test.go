@@ -0,0 +1,66 @@
+package badgerdb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/chain"
+	"github.com/drand/drand/chain/storetest"
+	"github.com/drand/drand/test"
+)
+
+func TestStoreBadger(t *testing.T) {
+	storetest.Run(t, func(t *testing.T, folder string) chain.Store {
+		store, err := NewBadgerStore(test.Logger(t), folder)
+		require.NoError(t, err)
+		return store
+	})
+}
+
+func TestStoreBadgerCursorDirection(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewBadgerStore(test.Logger(t), t.TempDir())
+	require.NoError(t, err)
+	defer store.Close(ctx)
+
+	for i := uint64(1); i <= 5; i++ {
+		require.NoError(t, store.Put(ctx, &chain.Beacon{Round: i, Signature: []byte{byte(i)}}))
+	}
+
+	// the cursor can go back and forth between both ends of the chain
+	err = store.Cursor(ctx, func(ctx context.Context, c chain.Cursor) error {
+		b, err := c.Last(ctx)
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), b.Round)
+		b, err = c.Next(ctx)
+		require.Error(t, err)
+		require.Nil(t, b)
+
+		b, err = c.Seek(ctx, 3)
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), b.Round)
+		b, err = c.Next(ctx)
+		require.NoError(t, err)
+		require.Equal(t, uint64(4), b.Round)
+
+		b, err = c.Last(ctx)
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), b.Round)
+		b, err = c.First(ctx)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), b.Round)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestStoreBadgerCloseTwice(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewBadgerStore(test.Logger(t), t.TempDir())
+	require.NoError(t, err)
+
+	require.NoError(t, store.Close(ctx))
+	require.NoError(t, store.Close(ctx))
+}
@@ -0,0 +1,207 @@
+package beacon
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	clock "github.com/jonboulle/clockwork"
+
+	"github.com/drand/drand/chain"
+	chainerrors "github.com/drand/drand/chain/errors"
+	commonutils "github.com/drand/drand/common"
+	"github.com/drand/drand/log"
+	"github.com/drand/drand/metrics"
+	"github.com/drand/drand/net"
+)
+
+// how many stored beacons the auditor verifies during a single run, so that a
+// node with a long chain spreads the initial verification over several runs
+var auditVerifyBatch = 10000
+
+// how many rounds the auditor asks the group to send again during a single run
+var auditMaxRepairs = 100
+
+// auditor periodically looks for the rounds missing from the store or whose
+// signature is invalid. It reports them as metrics and fetches them again from
+// the group peers through the sync manager.
+type auditor struct {
+	l        log.Logger
+	store    chain.Store
+	syncm    *SyncManager
+	info     *chain.Info
+	verifier *chain.Verifier
+	peers    func() []net.Peer
+	clock    clock.Clock
+	interval time.Duration
+	done     chan bool
+	stop     sync.Once
+	wg       sync.WaitGroup
+
+	// all the stored rounds up to verified have been checked once
+	verified uint64
+	// the invalid rounds found so far, checked again at every run
+	invalid []uint64
+}
+
+func newAuditor(l log.Logger, store chain.Store, syncm *SyncManager, info *chain.Info,
+	peers func() []net.Peer, cl clock.Clock, interval time.Duration) *auditor {
+	return &auditor{
+		l:        l.Named("auditor"),
+		store:    store,
+		syncm:    syncm,
+		info:     info,
+		verifier: chain.NewVerifier(info.Scheme),
+		peers:    peers,
+		clock:    cl,
+		interval: interval,
+		done:     make(chan bool),
+	}
+}
+
+// Start runs an audit every interval until Stop is called
+func (a *auditor) Start() {
+	a.wg.Add(1)
+	go a.run()
+}
+
+func (a *auditor) Stop() {
+	a.stop.Do(func() { close(a.done) })
+	a.wg.Wait()
+}
+
+func (a *auditor) run() {
+	defer a.wg.Done()
+
+	ticker := a.clock.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-a.done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	for {
+		select {
+		case <-ticker.Chan():
+		case <-ctx.Done():
+			return
+		}
+		if err := a.audit(ctx); err != nil && ctx.Err() == nil {
+			a.l.Errorw("", "auditor", "audit failed", "err", err)
+		}
+	}
+}
+
+// audit looks for missing and invalid rounds and tries to repair them
+func (a *auditor) audit(ctx context.Context) error {
+	gaps, err := a.store.Gaps(ctx)
+	if err != nil {
+		return err
+	}
+	var missing uint64
+	for _, g := range gaps {
+		missing += g.Len()
+	}
+
+	if err := a.checkInvalid(ctx); err != nil {
+		return err
+	}
+
+	metrics.ChainMissingRounds.WithLabelValues(commonutils.GetCanonicalBeaconID(a.info.ID)).Set(float64(missing))
+	metrics.ChainInvalidRounds.WithLabelValues(commonutils.GetCanonicalBeaconID(a.info.ID)).Set(float64(len(a.invalid)))
+
+	faulty := a.toRepair(gaps)
+	if len(faulty) == 0 {
+		return nil
+	}
+
+	a.l.Infow("", "auditor", "repairing", "missing", missing, "invalid", len(a.invalid), "rounds", len(faulty))
+	err = a.syncm.CorrectPastBeacons(ctx, faulty, a.peers(), func(r, u uint64) {})
+
+	// we count what got repaired even if some rounds failed
+	repaired := 0
+	for _, r := range faulty {
+		if a.isValid(ctx, r) {
+			repaired++
+		}
+	}
+	metrics.ChainRepairedRounds.WithLabelValues(commonutils.GetCanonicalBeaconID(a.info.ID)).Add(float64(repaired))
+	a.l.Infow("", "auditor", "repaired", "rounds", repaired, "of", len(faulty))
+
+	return err
+}
+
+// checkInvalid verifies again the rounds previously found invalid, then the
+// next batch of stored rounds not verified yet.
+func (a *auditor) checkInvalid(ctx context.Context) error {
+	var stillInvalid []uint64
+	for _, r := range a.invalid {
+		b, err := a.store.Get(ctx, r)
+		// missing and pruned rounds are not invalid anymore
+		if err == nil && a.verifier.VerifyBeacon(*b, a.info.PublicKey) != nil {
+			stillInvalid = append(stillInvalid, r)
+		}
+	}
+	a.invalid = stillInvalid
+
+	err := a.store.Cursor(ctx, func(ctx context.Context, c chain.Cursor) error {
+		b, err := c.Seek(ctx, a.verified+1)
+		for n := 0; b != nil && n < auditVerifyBatch; n++ {
+			if err != nil {
+				return err
+			}
+			if b.Round > 0 {
+				if err := a.verifier.VerifyBeacon(*b, a.info.PublicKey); err != nil {
+					a.l.Warnw("", "auditor", "invalid beacon", "round", b.Round, "err", err)
+					a.invalid = append(a.invalid, b.Round)
+				}
+				a.verified = b.Round
+			}
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			b, err = c.Next(ctx)
+		}
+		return err
+	})
+	if errors.Is(err, chainerrors.ErrNoBeaconStored) {
+		return nil
+	}
+	return err
+}
+
+func (a *auditor) isValid(ctx context.Context, round uint64) bool {
+	b, err := a.store.Get(ctx, round)
+	if err != nil {
+		return false
+	}
+	return a.verifier.VerifyBeacon(*b, a.info.PublicKey) == nil
+}
+
+// toRepair returns the rounds to fetch again during this run, invalid ones
+// first, at most auditMaxRepairs of them.
+func (a *auditor) toRepair(gaps []chain.RoundRange) []uint64 {
+	faulty := make([]uint64, 0, auditMaxRepairs)
+	for _, r := range a.invalid {
+		if len(faulty) == auditMaxRepairs {
+			return faulty
+		}
+		faulty = append(faulty, r)
+	}
+	for _, g := range gaps {
+		for r := g.From; r <= g.To; r++ {
+			if len(faulty) == auditMaxRepairs {
+				return faulty
+			}
+			faulty = append(faulty, r)
+		}
+	}
+	return faulty
+}
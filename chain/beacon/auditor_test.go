@@ -0,0 +1,150 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	clock "github.com/jonboulle/clockwork"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/chain"
+	"github.com/drand/drand/chain/boltdb"
+	"github.com/drand/drand/chain/memdb"
+	"github.com/drand/drand/common/scheme"
+	"github.com/drand/drand/key"
+	"github.com/drand/drand/metrics"
+	"github.com/drand/drand/net"
+	"github.com/drand/drand/protobuf/drand"
+	"github.com/drand/drand/test"
+	"github.com/drand/kyber/share"
+	"github.com/drand/kyber/util/random"
+)
+
+// testSyncClient serves sync requests from a store holding the whole chain
+type testSyncClient struct {
+	net.ProtocolClient
+	src chain.Store
+}
+
+func (c *testSyncClient) SyncChain(ctx context.Context, _ net.Peer, in *drand.SyncRequest,
+	_ ...net.CallOption) (chan *drand.BeaconPacket, error) {
+	ch := make(chan *drand.BeaconPacket)
+	go func() {
+		defer close(ch)
+		for r := in.GetFromRound(); ; r++ {
+			b, err := c.src.Get(ctx, r)
+			if err != nil {
+				return
+			}
+			select {
+			case ch <- beaconToProto(b):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// newAuditorTestChain returns the info of a new chain and a store holding its
+// first n beacons
+func newAuditorTestChain(t *testing.T, n uint64) (*chain.Info, chain.Store) {
+	t.Helper()
+	ctx := context.Background()
+	sch := scheme.GetSchemeFromEnv()
+	c := key.CryptoFor(sch)
+	secret := c.KeyGroup.Scalar().Pick(random.New())
+	info := &chain.Info{
+		ID:          "auditor_test",
+		PublicKey:   c.KeyGroup.Point().Mul(secret, nil),
+		Period:      time.Second,
+		Scheme:      sch,
+		GenesisTime: time.Now().Unix(),
+		GenesisSeed: []byte("genesis_seed"),
+	}
+
+	store := memdb.NewStore(test.Logger(t), int(n)+1)
+	prev := chain.GenesisBeacon(info)
+	require.NoError(t, store.Put(ctx, prev))
+
+	verifier := chain.NewVerifier(sch)
+	for i := uint64(1); i <= n; i++ {
+		b := &chain.Beacon{Round: i}
+		if !sch.DecouplePrevSig {
+			b.PreviousSig = prev.Signature
+		}
+		// the full signature is the partial of the only share of the secret,
+		// without its index
+		tsig, err := c.ThresholdScheme.Sign(&share.PriShare{V: secret}, verifier.DigestMessage(i, b.PreviousSig))
+		require.NoError(t, err)
+		b.Signature = tsig[2:]
+		require.NoError(t, store.Put(ctx, b))
+		prev = b
+	}
+	return info, store
+}
+
+func TestAuditorRepairs(t *testing.T) {
+	ctx := context.Background()
+	l := test.Logger(t)
+	info, src := newAuditorTestChain(t, 20)
+
+	// the local store misses rounds 4 to 6 and 15, and round 10 is invalid
+	store, err := boltdb.NewBoltStore(l, t.TempDir(), nil)
+	require.NoError(t, err)
+	defer store.Close(ctx)
+	for i := uint64(0); i <= 20; i++ {
+		if (i >= 4 && i <= 6) || i == 15 {
+			continue
+		}
+		b, err := src.Get(ctx, i)
+		require.NoError(t, err)
+		if i == 10 {
+			b = &chain.Beacon{Round: i, PreviousSig: b.PreviousSig, Signature: []byte("not a signature")}
+		}
+		require.NoError(t, store.Put(ctx, b))
+	}
+
+	syncm := NewSyncManager(&SyncConfig{
+		Log:         l,
+		Client:      &testSyncClient{src: src},
+		Clock:       clock.NewFakeClock(),
+		Store:       store,
+		BoltdbStore: store,
+		Info:        info,
+		NodeAddr:    "127.0.0.1:1",
+	})
+	peers := func() []net.Peer { return []net.Peer{net.CreatePeer("127.0.0.1:2", false)} }
+
+	// we make sure the repairs are spread over several runs
+	prev := auditMaxRepairs
+	auditMaxRepairs = 3
+	defer func() { auditMaxRepairs = prev }()
+
+	a := newAuditor(l, store, syncm, info, peers, clock.NewFakeClock(), time.Minute)
+	require.NoError(t, a.audit(ctx))
+	require.Equal(t, []uint64{10}, a.invalid)
+	require.Equal(t, float64(4), testutil.ToFloat64(metrics.ChainMissingRounds.WithLabelValues(info.ID)))
+	require.Equal(t, float64(1), testutil.ToFloat64(metrics.ChainInvalidRounds.WithLabelValues(info.ID)))
+	require.Equal(t, float64(3), testutil.ToFloat64(metrics.ChainRepairedRounds.WithLabelValues(info.ID)))
+
+	require.NoError(t, a.audit(ctx))
+	require.Empty(t, a.invalid)
+	require.Equal(t, float64(2), testutil.ToFloat64(metrics.ChainMissingRounds.WithLabelValues(info.ID)))
+	require.Equal(t, float64(5), testutil.ToFloat64(metrics.ChainRepairedRounds.WithLabelValues(info.ID)))
+
+	// the last run finds nothing left to repair
+	require.NoError(t, a.audit(ctx))
+	require.Zero(t, testutil.ToFloat64(metrics.ChainMissingRounds.WithLabelValues(info.ID)))
+	require.Zero(t, testutil.ToFloat64(metrics.ChainInvalidRounds.WithLabelValues(info.ID)))
+
+	for i := uint64(1); i <= 20; i++ {
+		expected, err := src.Get(ctx, i)
+		require.NoError(t, err)
+		b, err := store.Get(ctx, i)
+		require.NoError(t, err)
+		require.True(t, expected.Equal(b), "round %d", i)
+	}
+}
@@ -3,10 +3,13 @@ package beacon
 import (
 	"bytes"
 	"encoding/binary"
+	"sort"
 
+	"github.com/drand/drand/chain"
 	"github.com/drand/drand/key"
 	"github.com/drand/drand/log"
 	"github.com/drand/drand/protobuf/drand"
+	"github.com/drand/kyber/share"
 )
 
 // partialCache is a cache that stores (or not) all the partials the node
@@ -16,15 +19,21 @@ import (
 // partial signatures from the same index stored at any given time.
 type partialCache struct {
 	rounds map[string]*roundCache
-	rcvd   map[int][]string
-	l      log.Logger
+	// the rounds for which each node index has a verified partial
+	rcvd map[int][]string
+	// the rounds for which each node index has a partial not verified yet
+	pending map[int][]string
+	crypto  *key.Crypto
+	l       log.Logger
 }
 
-func newPartialCache(l log.Logger) *partialCache {
+func newPartialCache(l log.Logger, c *key.Crypto) *partialCache {
 	return &partialCache{
-		rounds: make(map[string]*roundCache),
-		rcvd:   make(map[int][]string),
-		l:      l,
+		rounds:  make(map[string]*roundCache),
+		rcvd:    make(map[int][]string),
+		pending: make(map[int][]string),
+		crypto:  c,
+		l:       l,
 	}
 }
 
@@ -35,17 +44,125 @@ func roundID(round uint64, previous []byte) string {
 	return buff.String()
 }
 
-// Append adds a partial signature to the cache.
+// Append adds a verified partial signature to the cache.
 func (c *partialCache) Append(p *drand.PartialBeaconPacket) {
+	c.add(p, nil)
+}
+
+// AppendUnverified adds a partial signature to the cache that must be verified
+// with VerifyRound before being used. Anyone can send a partial claiming any
+// index, so a partial is verified right away against pub when another one is
+// already waiting for its index in that round, rather than dropped as a
+// duplicate, and so is a partial whose index already has MaxPartialsPerNode
+// partials waiting.
+func (c *partialCache) AppendUnverified(p *drand.PartialBeaconPacket, pub *share.PubPoly) {
+	c.add(p, pub)
+}
+
+// add stores the partial, as waiting for verification if pub is set
+func (c *partialCache) add(p *drand.PartialBeaconPacket, pub *share.PubPoly) {
 	id := roundID(p.GetRound(), p.GetPreviousSig())
-	idx, _ := key.Scheme.IndexOf(p.GetPartialSig())
-	round := c.getCache(id, p)
-	if round == nil {
+	idx, _ := c.crypto.ThresholdScheme.IndexOf(p.GetPartialSig())
+	round, ok := c.rounds[id]
+	if !ok {
+		round = newRoundCache(id, p)
+	}
+	if _, seen := round.sigs[idx]; seen {
 		return
 	}
-	if round.append(p) {
-		// we increment the counter of that node index
-		c.rcvd[idx] = append(c.rcvd[idx], id)
+	sig := p.GetPartialSig()
+
+	waiting, conflict := round.unverified[idx]
+	if pub != nil {
+		if conflict && bytes.Equal(waiting, sig) {
+			return
+		}
+		if !conflict && len(c.pending[idx]) < MaxPartialsPerNode {
+			round.unverified[idx] = sig
+			c.pending[idx] = append(c.pending[idx], id)
+			c.rounds[id] = round
+			return
+		}
+		msg := c.crypto.Digest(round.round, round.prev)
+		if err := c.crypto.ThresholdScheme.VerifyPartial(pub, msg, sig); err != nil {
+			c.l.Debugw("", "cache", "invalid partial", "round", round.round, "from_idx", idx, "err", err)
+			return
+		}
+	}
+	// the partial waiting for that index is superseded by a verified one
+	if conflict {
+		delete(round.unverified, idx)
+		c.forget(c.pending, idx, id)
+	}
+	c.rounds[id] = round
+	c.record(round, idx, sig)
+}
+
+// record stores the verified partial of the node index, evicting its oldest
+// partial if it already has MaxPartialsPerNode of them
+func (c *partialCache) record(round *roundCache, idx int, sig []byte) {
+	if len(c.rcvd[idx]) >= MaxPartialsPerNode {
+		// this node has submitted too many partials - we take the last one off
+		toEvict := c.rcvd[idx][0]
+		c.rcvd[idx] = c.rcvd[idx][1:]
+		if old, ok := c.rounds[toEvict]; ok {
+			old.flushIndex(idx)
+			// if the round is now empty, delete it
+			if old.Len() == 0 {
+				delete(c.rounds, toEvict)
+			}
+		} else {
+			c.l.Errorw("", "cache", "miss", "node", idx, "not_present_for", round.round)
+		}
+	}
+	if round.append(idx, sig) {
+		c.rcvd[idx] = append(c.rcvd[idx], round.id)
+	}
+}
+
+// VerifyRound verifies the partials of the round cache not verified yet, all
+// at once. If the batch is invalid, each partial is verified on its own and
+// the invalid ones are removed from the cache. It returns the indexes of the
+// invalid partials.
+func (c *partialCache) VerifyRound(r *roundCache, pub *share.PubPoly, msg []byte) []int {
+	if len(r.unverified) == 0 {
+		return nil
+	}
+	indexes := make([]int, 0, len(r.unverified))
+	partials := make([][]byte, 0, len(r.unverified))
+	for idx, sig := range r.unverified {
+		indexes = append(indexes, idx)
+		partials = append(partials, sig)
+		c.forget(c.pending, idx, r.id)
+	}
+	r.unverified = make(map[int][]byte)
+	batchValid := c.crypto.BatchVerifyPartials(pub, msg, partials) == nil
+
+	var invalid []int
+	for i, idx := range indexes {
+		if !batchValid && c.crypto.ThresholdScheme.VerifyPartial(pub, msg, partials[i]) != nil {
+			invalid = append(invalid, idx)
+			continue
+		}
+		c.record(r, idx, partials[i])
+	}
+	sort.Ints(invalid)
+	return invalid
+}
+
+// forget removes the round from the rounds counted for the node index
+func (c *partialCache) forget(counts map[int][]string, idx int, id string) {
+	var idSlice = counts[idx][:0]
+	for _, idd := range counts[idx] {
+		if idd == id {
+			continue
+		}
+		idSlice = append(idSlice, idd)
+	}
+	if len(idSlice) > 0 {
+		counts[idx] = idSlice
+	} else {
+		delete(counts, idx)
 	}
 }
 
@@ -60,18 +177,10 @@ func (c *partialCache) FlushRounds(round uint64) {
 		delete(c.rounds, id)
 		// delete the counter of each nodes that participated in that round
 		for idx := range cache.sigs {
-			var idSlice = c.rcvd[idx][:0]
-			for _, idd := range c.rcvd[idx] {
-				if idd == id {
-					continue
-				}
-				idSlice = append(idSlice, idd)
-			}
-			if len(idSlice) > 0 {
-				c.rcvd[idx] = idSlice
-			} else {
-				delete(c.rcvd, idx)
-			}
+			c.forget(c.rcvd, idx, id)
+		}
+		for idx := range cache.unverified {
+			c.forget(c.pending, idx, id)
 		}
 	}
 }
@@ -81,67 +190,44 @@ func (c *partialCache) GetRoundCache(round uint64, previous []byte) *roundCache
 	return c.rounds[id]
 }
 
-// newRoundCache creates a new round cache given p. If the signer of the partial
-// already has more than `
-func (c *partialCache) getCache(id string, p *drand.PartialBeaconPacket) *roundCache {
-	if round, ok := c.rounds[id]; ok {
-		return round
-	}
-
-	idx, _ := key.Scheme.IndexOf(p.GetPartialSig())
-	if len(c.rcvd[idx]) >= MaxPartialsPerNode {
-		// this node has submitted too many partials - we take the last one off
-		toEvict := c.rcvd[idx][0]
-		round, ok := c.rounds[toEvict]
-		if !ok {
-			c.l.Errorw("", "beacon_id", id, "cache", "miss", "node", idx, "not_present_for", p.GetRound())
-			return nil
-		}
-		round.flushIndex(idx)
-		c.rcvd[idx] = append(c.rcvd[idx][1:], id)
-		// if the round is now empty, delete it
-		if round.Len() == 0 {
-			delete(c.rounds, toEvict)
-		}
-	}
-	round := newRoundCache(id, p)
-	c.rounds[id] = round
-	return round
-}
-
 type roundCache struct {
 	round uint64
 	prev  []byte
 	id    string
-	sigs  map[int][]byte
+	// the verified partials
+	sigs map[int][]byte
+	// the partials not verified yet, for the indexes without a verified one
+	unverified map[int][]byte
 }
 
 func newRoundCache(id string, p *drand.PartialBeaconPacket) *roundCache {
 	return &roundCache{
-		round: p.GetRound(),
-		prev:  p.GetPreviousSig(),
-		id:    id,
-		sigs:  make(map[int][]byte),
+		round:      p.GetRound(),
+		prev:       p.GetPreviousSig(),
+		id:         id,
+		sigs:       make(map[int][]byte),
+		unverified: make(map[int][]byte),
 	}
 }
 
-// append stores the partial and returns true if the partial is not stored . It
-// returns false if the cache is already caching this partial signature.
-func (r *roundCache) append(p *drand.PartialBeaconPacket) bool {
-	idx, _ := key.Scheme.IndexOf(p.GetPartialSig())
+// append stores the partial of the given index and returns true if the partial
+// is not stored . It returns false if the cache is already caching this partial
+// signature.
+func (r *roundCache) append(idx int, sig []byte) bool {
 	if _, seen := r.sigs[idx]; seen {
 		return false
 	}
-	r.sigs[idx] = p.GetPartialSig()
+	r.sigs[idx] = sig
 	return true
 }
 
-// Len shows how many items are in the cache
+// Len shows how many items are in the cache, counting the partials not
+// verified yet
 func (r *roundCache) Len() int {
-	return len(r.sigs)
+	return len(r.sigs) + len(r.unverified)
 }
 
-// Partials provides all cached partial signatures
+// Partials provides all cached partial signatures that were verified
 func (r *roundCache) Partials() [][]byte {
 	partials := make([][]byte, 0, len(r.sigs))
 	for _, sig := range r.sigs {
@@ -150,6 +236,25 @@ func (r *roundCache) Partials() [][]byte {
 	return partials
 }
 
+// Contributors returns the record of the nodes whose partials are cached, in a
+// group of n nodes, along with the partials themselves if withPartials is set.
+func (r *roundCache) Contributors(n int, withPartials bool) *chain.Contributors {
+	indexes := make([]int, 0, len(r.sigs))
+	for idx := range r.sigs {
+		indexes = append(indexes, idx)
+	}
+	sort.Ints(indexes)
+
+	c := chain.NewContributors(r.round, n, indexes)
+	if withPartials {
+		for _, idx := range indexes {
+			c.Partials = append(c.Partials, r.sigs[idx])
+		}
+	}
+	return c
+}
+
 func (r *roundCache) flushIndex(idx int) {
 	delete(r.sigs, idx)
+	delete(r.unverified, idx)
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/drand/drand/log"
 	"github.com/drand/drand/protobuf/drand"
 	"github.com/drand/kyber/share"
+	"github.com/drand/kyber/util/random"
 )
 
 var fakeKey = key.NewKeyPair("127.0.0.1:8080")
@@ -25,7 +26,7 @@ func generatePartial(idx int, round uint64, prev []byte) *drand.PartialBeaconPac
 	}
 
 	msg := verifier.DigestMessage(round, prev)
-	sig, _ := key.Scheme.Sign(sh, msg)
+	sig, _ := key.CryptoFor(sch).ThresholdScheme.Sign(sh, msg)
 	return &drand.PartialBeaconPacket{
 		Round:       round,
 		PreviousSig: prev,
@@ -45,12 +46,12 @@ func TestCacheRound(t *testing.T) {
 	partial := generatePartial(1, round, prev)
 	p2 := generatePartial(2, round, prev)
 	cache := newRoundCache(id, partial)
-	require.True(t, cache.append(partial))
-	require.False(t, cache.append(partial))
+	require.True(t, cache.append(1, partial.GetPartialSig()))
+	require.False(t, cache.append(1, partial.GetPartialSig()))
 	require.Equal(t, 1, cache.Len())
 	require.Equal(t, msg, verifier.DigestMessage(cache.round, cache.prev))
 
-	require.True(t, cache.append(p2))
+	require.True(t, cache.append(2, p2.GetPartialSig()))
 	require.Equal(t, 2, cache.Len())
 	require.Contains(t, cache.Partials(), partial.GetPartialSig())
 	require.Contains(t, cache.Partials(), p2.GetPartialSig())
@@ -61,7 +62,7 @@ func TestCacheRound(t *testing.T) {
 
 func TestCachePartial(t *testing.T) {
 	l := log.DefaultLogger()
-	cache := newPartialCache(l)
+	cache := newPartialCache(l, key.CryptoFor(scheme.GetSchemeFromEnv()))
 	var round uint64 = 64
 	prev := []byte("yesterday was another day")
 
@@ -107,3 +108,112 @@ func TestCachePartial(t *testing.T) {
 		require.Nil(t, cache.rcvd[i+1], "failed for signer %d", i+1)
 	}
 }
+
+func TestCacheContributors(t *testing.T) {
+	cache := newPartialCache(log.DefaultLogger(), key.CryptoFor(scheme.GetSchemeFromEnv()))
+	var round uint64 = 12
+	prev := []byte("yesterday was another day")
+
+	partials := map[int]*drand.PartialBeaconPacket{}
+	for _, idx := range []int{4, 0, 2} {
+		partials[idx] = generatePartial(idx, round, prev)
+		cache.Append(partials[idx])
+	}
+
+	c := cache.GetRoundCache(round, prev).Contributors(5, false)
+	require.Equal(t, round, c.Round)
+	require.Equal(t, []int{0, 2, 4}, c.Indexes())
+	require.Empty(t, c.Partials)
+
+	// the partials are ordered like the indexes
+	c = cache.GetRoundCache(round, prev).Contributors(5, true)
+	require.Equal(t, [][]byte{
+		partials[0].GetPartialSig(), partials[2].GetPartialSig(), partials[4].GetPartialSig(),
+	}, c.Partials)
+}
+
+func TestCacheVerifyRound(t *testing.T) {
+	sch := scheme.GetSchemeFromEnv()
+	c := key.CryptoFor(sch)
+	cache := newPartialCache(log.DefaultLogger(), c)
+	verifier := chain.NewVerifier(sch)
+	var round uint64 = 12
+	prev := []byte("yesterday was another day")
+	msg := verifier.DigestMessage(round, prev)
+
+	n, thr := 5, 3
+	priv := share.NewPriPoly(c.KeyGroup, thr, nil, random.New())
+	pub := priv.Commit(nil)
+	shares := priv.Shares(n)
+	packet := func(idx int, msg []byte) *drand.PartialBeaconPacket {
+		sig, err := c.ThresholdScheme.Sign(shares[idx], msg)
+		require.NoError(t, err)
+		return &drand.PartialBeaconPacket{Round: round, PreviousSig: prev, PartialSig: sig}
+	}
+
+	cache.Append(packet(0, msg))
+	cache.AppendUnverified(packet(1, msg), pub)
+	cache.AppendUnverified(packet(2, msg), pub)
+	// signed over another message
+	cache.AppendUnverified(packet(3, verifier.DigestMessage(round+1, prev)), pub)
+	cache.AppendUnverified(packet(4, msg), pub)
+	rc := cache.GetRoundCache(round, prev)
+	require.Equal(t, 5, rc.Len())
+	require.Len(t, rc.unverified, 4)
+	// only the verified partials count against the limit of their node
+	require.Nil(t, cache.rcvd[4])
+	require.Len(t, cache.pending[4], 1)
+
+	require.Equal(t, []int{3}, cache.VerifyRound(rc, pub, msg))
+	require.Equal(t, 4, rc.Len())
+	require.Empty(t, rc.unverified)
+	require.Empty(t, cache.pending)
+	require.Nil(t, cache.rcvd[3])
+	require.Len(t, cache.rcvd[4], 1)
+
+	// only the new partials are verified, and a valid batch removes nothing
+	cache.AppendUnverified(packet(3, msg), pub)
+	require.Empty(t, cache.VerifyRound(rc, pub, msg))
+	require.Equal(t, 5, rc.Len())
+
+	sig, err := c.RecoverVerified(rc.Partials(), thr, n)
+	require.NoError(t, err)
+	require.NoError(t, c.ThresholdScheme.VerifyRecovered(pub.Commit(), msg, sig))
+}
+
+func TestCacheForgedPartial(t *testing.T) {
+	sch := scheme.GetSchemeFromEnv()
+	c := key.CryptoFor(sch)
+	cache := newPartialCache(log.DefaultLogger(), c)
+	verifier := chain.NewVerifier(sch)
+	var round uint64 = 12
+	prev := []byte("yesterday was another day")
+	msg := verifier.DigestMessage(round, prev)
+
+	priv := share.NewPriPoly(c.KeyGroup, 2, nil, random.New())
+	pub := priv.Commit(nil)
+	forger := share.NewPriPoly(c.KeyGroup, 2, nil, random.New())
+	packet := func(s *share.PriShare) *drand.PartialBeaconPacket {
+		sig, err := c.ThresholdScheme.Sign(s, msg)
+		require.NoError(t, err)
+		return &drand.PartialBeaconPacket{Round: round, PreviousSig: prev, PartialSig: sig}
+	}
+	honest, forged := packet(priv.Eval(1)), packet(forger.Eval(1))
+
+	// a forged partial gets in first, the valid one doesn't get dropped as a
+	// duplicate of it
+	cache.AppendUnverified(forged, pub)
+	cache.AppendUnverified(honest, pub)
+	rc := cache.GetRoundCache(round, prev)
+	require.Equal(t, 1, rc.Len())
+	require.Empty(t, rc.unverified)
+	require.Equal(t, honest.GetPartialSig(), rc.sigs[1])
+	require.Empty(t, cache.pending)
+
+	// nor can a forged partial take the place of a valid one waiting
+	cache.AppendUnverified(packet(priv.Eval(2)), pub)
+	cache.AppendUnverified(packet(forger.Eval(2)), pub)
+	require.Empty(t, cache.VerifyRound(rc, pub, msg))
+	require.Equal(t, 2, rc.Len())
+	require.Len(t, cache.rcvd[2], 1)
+}
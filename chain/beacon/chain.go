@@ -25,6 +25,9 @@ type chainStore struct {
 	conf        *Config
 	client      net.ProtocolClient
 	syncm       *SyncManager
+	auditor     *auditor
+	partials    *partialTracker
+	gossip      *gossip
 	verifier    *chain.Verifier
 	crypto      *cryptoStore
 	ticker      *ticker
@@ -53,13 +56,15 @@ func newChainStore(l log.Logger, cf *Config, cl net.ProtocolClient, c *cryptoSto
 
 	// we give the final append store to the sync manager
 	syncm := NewSyncManager(&SyncConfig{
-		Log:         l,
-		Store:       cbs,
-		BoltdbStore: store,
-		Info:        c.chain,
-		Client:      cl,
-		Clock:       cf.Clock,
-		NodeAddr:    cf.Public.Address(),
+		Log:            l,
+		Store:          cbs,
+		BoltdbStore:    store,
+		Info:           c.chain,
+		Client:         cl,
+		Clock:          cf.Clock,
+		NodeAddr:       cf.Public.Address(),
+		Reputation:     cf.Reputation,
+		CheckpointFile: cf.CheckpointFile,
 	})
 	go syncm.Run()
 
@@ -73,6 +78,8 @@ func newChainStore(l log.Logger, cf *Config, cl net.ProtocolClient, c *cryptoSto
 		syncm:           syncm,
 		verifier:        verifier,
 		crypto:          c,
+		partials:        newPartialTracker(c),
+		gossip:          newGossip(),
 		ticker:          t,
 		done:            make(chan bool, 1),
 		newPartials:     make(chan partialInfo, defaultPartialChanBuffer),
@@ -84,8 +91,21 @@ func newChainStore(l log.Logger, cf *Config, cl net.ProtocolClient, c *cryptoSto
 	cbs.AddCallback("chainstore", func(b *chain.Beacon) {
 		cs.beaconStoredAgg <- b
 	})
+	// the partials of a round stop being awaited once the next beacon is in
+	cbs.AddCallback("partials", func(b *chain.Beacon) {
+		cs.partials.Close(b.Round - 1)
+	})
+	cbs.AddCallback("gossip", func(b *chain.Beacon) {
+		cs.gossip.Aggregated(b.Round)
+	})
 	// TODO maybe look if it's worth having multiple workers there
 	go cs.runAggregator()
+
+	if cf.AuditInterval > 0 {
+		peers := func() []net.Peer { return toPeers(c.GetGroup().Nodes) }
+		cs.auditor = newAuditor(l, store, syncm, c.chain, peers, cf.Clock, cf.AuditInterval)
+		cs.auditor.Start()
+	}
 	return cs
 }
 
@@ -96,7 +116,20 @@ func (c *chainStore) NewValidPartial(addr string, p *drand.PartialBeaconPacket)
 	}
 }
 
+// NewUnverifiedPartial hands over a partial that is verified along with the
+// other partials of its round once there are enough of them to aggregate.
+func (c *chainStore) NewUnverifiedPartial(addr string, p *drand.PartialBeaconPacket) {
+	c.newPartials <- partialInfo{
+		addr:       addr,
+		p:          p,
+		unverified: true,
+	}
+}
+
 func (c *chainStore) Stop() {
+	if c.auditor != nil {
+		c.auditor.Stop()
+	}
 	c.syncm.Stop()
 	c.CallbackStore.Close(context.Background())
 	close(c.done)
@@ -115,7 +148,8 @@ func (c *chainStore) runAggregator() {
 		c.l.Fatalw("", "chain_aggregator", "loading", "last_beacon", err)
 	}
 
-	var cache = newPartialCache(c.l)
+	sch := c.crypto.GetCrypto()
+	var cache = newPartialCache(c.l, sch)
 	for {
 		select {
 		case <-c.done:
@@ -141,7 +175,11 @@ func (c *chainStore) runAggregator() {
 			// crypto store.
 			thr := c.crypto.GetGroup().Threshold
 			n := c.crypto.GetGroup().Len()
-			cache.Append(partial.p)
+			if partial.unverified {
+				cache.AppendUnverified(partial.p, c.crypto.GetPub())
+			} else {
+				cache.Append(partial.p)
+			}
 			roundCache := cache.GetRoundCache(partial.p.GetRound(), partial.p.GetPreviousSig())
 			if roundCache == nil {
 				c.l.Errorw("", "store_partial", partial.addr, "no_round_cache", partial.p.GetRound())
@@ -156,12 +194,28 @@ func (c *chainStore) runAggregator() {
 
 			msg := c.verifier.DigestMessage(roundCache.round, roundCache.prev)
 
-			finalSig, err := key.Scheme.Recover(c.crypto.GetPub(), msg, roundCache.Partials(), thr, n)
+			var finalSig []byte
+			var err error
+			if c.conf.BatchVerifyPartials {
+				invalid := cache.VerifyRound(roundCache, c.crypto.GetPub(), msg)
+				if len(invalid) > 0 {
+					// the index of a partial isn't authenticated, we can't tell
+					// who forged them
+					c.l.Errorw("", "store_partial", "invalid partials", "round", roundCache.round, "claimed_idx", invalid)
+				}
+				if roundCache.Len() < thr {
+					break
+				}
+				// all the partials of the round are verified by now
+				finalSig, err = sch.RecoverVerified(roundCache.Partials(), thr, n)
+			} else {
+				finalSig, err = sch.ThresholdScheme.Recover(c.crypto.GetPub(), msg, roundCache.Partials(), thr, n)
+			}
 			if err != nil {
 				c.l.Errorw("invalid_recovery", "error", err, "round", pRound, "got", fmt.Sprintf("%d/%d", roundCache.Len(), n))
 				break
 			}
-			if err := key.Scheme.VerifyRecovered(c.crypto.GetPub().Commit(), msg, finalSig); err != nil {
+			if err := sch.ThresholdScheme.VerifyRecovered(c.crypto.GetPub().Commit(), msg, finalSig); err != nil {
 				c.l.Errorw("invalid_sig", "error", err, "round", pRound)
 				break
 			}
@@ -176,6 +230,10 @@ func (c *chainStore) runAggregator() {
 			c.l.Infow("", "aggregated_beacon", newBeacon.Round)
 			if c.tryAppend(lastBeacon, newBeacon) {
 				lastBeacon = newBeacon
+				contributors := roundCache.Contributors(n, c.conf.StorePartials)
+				if err := c.PutContributors(context.Background(), contributors); err != nil {
+					c.l.Errorw("", "chain_store", "error storing contributors", "round", newBeacon.Round, "err", err)
+				}
 				break
 			}
 			// XXX store them for future usage if it's a later round than what we have
@@ -251,8 +309,9 @@ func (c *chainStore) AppendedBeaconNoSync() chan *chain.Beacon {
 }
 
 type partialInfo struct {
-	addr string
-	p    *drand.PartialBeaconPacket
+	addr       string
+	p          *drand.PartialBeaconPacket
+	unverified bool
 }
 
 func toPeers(nodes []*key.Node) []net.Peer {
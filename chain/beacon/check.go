@@ -0,0 +1,364 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+
+	"github.com/drand/drand/chain"
+	chainerrors "github.com/drand/drand/chain/errors"
+	commonutils "github.com/drand/drand/common"
+)
+
+// how many rounds a worker checks at once. The chunks end on multiples of
+// that size, which are multiples of the rounds the progress logs skip.
+var checkChunkSize uint64 = 10 * commonutils.LogsToSkip
+
+// how many chunks are checked at the same time
+var checkWorkers = runtime.NumCPU()
+
+// checkCheckpoint records how far an interrupted check of the chain went, so
+// that the next check resumes from there
+type checkCheckpoint struct {
+	ChainHash string `json:"chain_hash"`
+	// Verified is the round up to which all the stored rounds were checked
+	Verified uint64 `json:"verified"`
+	// Faulty are the rounds found invalid or missing up to Verified
+	Faulty []uint64 `json:"faulty"`
+}
+
+// checkResult is the outcome of the check of a chunk of rounds
+type checkResult struct {
+	chunk  syncChunk
+	faulty []uint64
+	// the beacons at both ends of the chunk, when they are valid, to check
+	// the linkage between chunks
+	first, last *chain.Beacon
+	err         error
+}
+
+// CheckPastBeacons verifies the stored rounds up to the given round, or up to
+// the last stored one if upTo is 0. It returns the rounds that are invalid or
+// missing, excluding the pruned ones. The range is split into chunks checked
+// by a pool of workers, the progress being reported through the callback as
+// the chunks complete in order. When the SyncManager has a checkpoint file,
+// an interrupted check saves how far it went and the next one resumes from
+// there.
+func (s *SyncManager) CheckPastBeacons(ctx context.Context, upTo uint64, cb func(r, u uint64)) ([]uint64, error) {
+	logger := s.log.Named("pastBeaconCheck")
+	logger.Debugw("Starting to check past beacons", "upTo", upTo)
+
+	last, err := s.store.Last(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch and check last beacon in store: %w", err)
+	}
+
+	if last.Round < upTo {
+		logger.Errorw("No beacon stored above", "last round", last.Round, "requested round", upTo)
+		logger.Infow("Checking beacons only up to the last stored", "round", last.Round)
+	}
+	if upTo == 0 || last.Round < upTo {
+		upTo = last.Round
+	}
+
+	// hashing the chain info marshals its public key, which the workers
+	// shouldn't race with
+	hash := hex.EncodeToString(s.info.Hash())
+
+	// the rounds found faulty before the checkpoint may have been corrected
+	// since then, we check them again
+	cp := s.loadCheckpoint(hash)
+	if cp.Verified > 0 {
+		logger.Infow("Resuming the check from a checkpoint", "verified", cp.Verified, "faulty", len(cp.Faulty))
+	}
+	var faultyBeacons []uint64
+	for _, r := range cp.Faulty {
+		if r <= upTo && !s.checkRound(ctx, r) {
+			faultyBeacons = append(faultyBeacons, r)
+		}
+	}
+
+	var chunks []syncChunk
+	for from := cp.Verified + 1; from <= upTo; {
+		to := (from/checkChunkSize + 1) * checkChunkSize
+		if to > upTo {
+			to = upTo
+		}
+		chunks = append(chunks, syncChunk{idx: len(chunks), from: from, to: to})
+		from = to + 1
+	}
+
+	if len(chunks) > 0 {
+		faultyBeacons, err = s.checkChunks(ctx, chunks, faultyBeacons, upTo, hash, cb)
+		if err != nil {
+			return nil, err
+		}
+	}
+	s.removeCheckpoint()
+
+	logger.Debugw("Finished checking past beacons", "faulty_beacons", len(faultyBeacons))
+
+	if len(faultyBeacons) > 0 {
+		logger.Warnw("Found invalid beacons in store", "amount", len(faultyBeacons))
+		return faultyBeacons, nil
+	}
+
+	return nil, nil
+}
+
+// checkChunks checks the chunks with a pool of workers. The chunks are
+// gathered in order, to check the linkage between them and to save the
+// checkpoint after each of them.
+func (s *SyncManager) checkChunks(ctx context.Context, chunks []syncChunk, faulty []uint64,
+	upTo uint64, hash string, cb func(r, u uint64)) ([]uint64, error) {
+	// the workers are done once we return, even when the check is canceled
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	workers := checkWorkers
+	if workers > len(chunks) {
+		workers = len(chunks)
+	}
+	todo := make(chan syncChunk, len(chunks))
+	for _, c := range chunks {
+		todo <- c
+	}
+	close(todo)
+	results := make(chan checkResult, workers)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for c := range todo {
+				select {
+				case results <- s.checkChunk(ctx, c):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	// the first chunk links to the round before it, if it's valid
+	var prev *chain.Beacon
+	if from := chunks[0].from; from > 0 {
+		if b, err := s.store.Get(ctx, from-1); err == nil && s.checkRound(ctx, from-1) {
+			prev = b
+		}
+	}
+
+	pending := make(map[int]checkResult)
+	for next := 0; next < len(chunks); {
+		var res checkResult
+		select {
+		case res = <-results:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if res.err != nil {
+			return nil, fmt.Errorf("unable to check rounds %d to %d: %w", res.chunk.from, res.chunk.to, res.err)
+		}
+
+		pending[res.chunk.idx] = res
+		for r, ok := pending[next]; ok; r, ok = pending[next] {
+			delete(pending, next)
+			if s.verifier.IsPrevSigMeaningful() && prev != nil && r.first != nil &&
+				!bytes.Equal(prev.Signature, r.first.PreviousSig) {
+				s.log.Errorw("invalid_beacon", "round", r.first.Round, "err", "previous signature doesn't match")
+				faulty = append(faulty, r.first.Round)
+			}
+			faulty = append(faulty, r.faulty...)
+			prev = r.last
+
+			s.saveCheckpoint(&checkCheckpoint{ChainHash: hash, Verified: r.chunk.to, Faulty: faulty})
+			if cb != nil {
+				cb(r.chunk.to, upTo)
+			}
+			next++
+		}
+	}
+	return faulty, nil
+}
+
+// checkChunk verifies the rounds of the chunk through a cursor over the store
+func (s *SyncManager) checkChunk(ctx context.Context, c syncChunk) checkResult {
+	res := checkResult{chunk: c}
+	chained := s.verifier.IsPrevSigMeaningful()
+
+	var gaps []chain.RoundRange
+	res.err = s.store.Cursor(ctx, func(ctx context.Context, cur chain.Cursor) error {
+		// the previous round, if it's valid
+		var prev *chain.Beacon
+		next := c.from
+		b, err := cur.Seek(ctx, c.from)
+		for ; err == nil && b.Round <= c.to; b, err = cur.Next(ctx) {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if b.Round > next {
+				gaps = append(gaps, chain.RoundRange{From: next, To: b.Round - 1})
+				prev = nil
+			}
+			next = b.Round + 1
+
+			if err := s.verifier.VerifyBeacon(*b, s.info.PublicKey); err != nil {
+				s.log.Errorw("invalid_beacon", "round", b.Round, "err", err)
+				res.faulty = append(res.faulty, b.Round)
+				prev = nil
+				continue
+			}
+			if chained && prev != nil && !bytes.Equal(prev.Signature, b.PreviousSig) {
+				s.log.Errorw("invalid_beacon", "round", b.Round, "err", "previous signature doesn't match")
+				res.faulty = append(res.faulty, b.Round)
+				prev = nil
+				continue
+			}
+			if b.Round%commonutils.LogsToSkip == 0 { // we do some rate limiting on the logging
+				s.log.Debugw("valid_beacon", "round", b.Round)
+			}
+
+			if b.Round == c.from {
+				res.first = b
+			}
+			if b.Round == c.to {
+				res.last = b
+			}
+			prev = b
+		}
+		if err != nil && !errors.Is(err, chainerrors.ErrNoBeaconStored) {
+			// the rounds after an unreadable beacon are checked one by one
+			s.log.Errorw("unable to read beacon in store", "after_round", next-1, "err", err)
+		}
+		if next <= c.to {
+			gaps = append(gaps, chain.RoundRange{From: next, To: c.to})
+		}
+		return nil
+	})
+	if res.err != nil {
+		return res
+	}
+
+	// the rounds the cursor skipped are missing or unreadable, unless they
+	// were pruned
+	for _, g := range gaps {
+		// the pruned rounds are the oldest ones
+		if _, err := s.store.Get(ctx, g.To); errors.Is(err, chainerrors.ErrBeaconPruned) {
+			continue
+		}
+		var missing []uint64
+		for r := g.From; r <= g.To; r++ {
+			if !s.checkRound(ctx, r) {
+				s.log.Errorw("unable to fetch beacon in store", "round", r)
+				missing = append(missing, r)
+			}
+		}
+		res.faulty = mergeRounds(res.faulty, missing)
+	}
+	return res
+}
+
+// checkRound returns whether the stored round is valid, or pruned
+func (s *SyncManager) checkRound(ctx context.Context, round uint64) bool {
+	b, err := s.store.Get(ctx, round)
+	if errors.Is(err, chainerrors.ErrBeaconPruned) {
+		return true
+	}
+	if err != nil {
+		return false
+	}
+	if round == 0 {
+		return true
+	}
+	if s.verifier.VerifyBeacon(*b, s.info.PublicKey) != nil {
+		return false
+	}
+	if !s.verifier.IsPrevSigMeaningful() {
+		return true
+	}
+	prev, err := s.store.Get(ctx, round-1)
+	return err != nil || bytes.Equal(prev.Signature, b.PreviousSig)
+}
+
+// mergeRounds merges two sorted lists of rounds
+func mergeRounds(a, b []uint64) []uint64 {
+	merged := make([]uint64, 0, len(a)+len(b))
+	for len(a) > 0 && len(b) > 0 {
+		if a[0] < b[0] {
+			merged, a = append(merged, a[0]), a[1:]
+		} else {
+			merged, b = append(merged, b[0]), b[1:]
+		}
+	}
+	merged = append(merged, a...)
+	return append(merged, b...)
+}
+
+// loadCheckpoint returns the checkpoint of an interrupted check of the chain
+// with the given hash, or an empty one
+func (s *SyncManager) loadCheckpoint(hash string) *checkCheckpoint {
+	cp := new(checkCheckpoint)
+	if s.checkpointFile == "" {
+		return cp
+	}
+	data, err := os.ReadFile(s.checkpointFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return cp
+	}
+	if err == nil {
+		err = json.Unmarshal(data, cp)
+	}
+	if err != nil {
+		s.log.Warnw("ignoring unreadable check checkpoint", "file", s.checkpointFile, "err", err)
+		return new(checkCheckpoint)
+	}
+	if cp.ChainHash != hash {
+		s.log.Warnw("ignoring check checkpoint of another chain", "file", s.checkpointFile, "chain_hash", cp.ChainHash)
+		return new(checkCheckpoint)
+	}
+	return cp
+}
+
+// saveCheckpoint writes the checkpoint to a temporary file first, so that a
+// crash never leaves a partial checkpoint behind
+func (s *SyncManager) saveCheckpoint(cp *checkCheckpoint) {
+	if s.checkpointFile == "" {
+		return
+	}
+	data, err := json.Marshal(cp)
+	if err != nil {
+		s.log.Warnw("unable to encode check checkpoint", "err", err)
+		return
+	}
+	tmp := s.checkpointFile + ".tmp"
+	if err := os.MkdirAll(filepath.Dir(s.checkpointFile), 0o700); err != nil {
+		s.log.Warnw("unable to save check checkpoint", "file", s.checkpointFile, "err", err)
+		return
+	}
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		s.log.Warnw("unable to save check checkpoint", "file", tmp, "err", err)
+		return
+	}
+	if err := os.Rename(tmp, s.checkpointFile); err != nil {
+		s.log.Warnw("unable to save check checkpoint", "file", s.checkpointFile, "err", err)
+	}
+}
+
+// removeCheckpoint deletes the checkpoint once a check completed, so that the
+// next check starts over
+func (s *SyncManager) removeCheckpoint() {
+	if s.checkpointFile == "" {
+		return
+	}
+	if err := os.Remove(s.checkpointFile); err != nil && !errors.Is(err, os.ErrNotExist) {
+		s.log.Warnw("unable to remove check checkpoint", "file", s.checkpointFile, "err", err)
+	}
+}
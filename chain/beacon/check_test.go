@@ -0,0 +1,153 @@
+package beacon
+
+import (
+	"context"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	clock "github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/chain"
+	"github.com/drand/drand/chain/memdb"
+	"github.com/drand/drand/test"
+)
+
+// newCheckTestStore copies the chain of src into a new store, without the
+// missing rounds and with an invalid signature for the invalid ones
+func newCheckTestStore(t *testing.T, src chain.Store, n uint64, invalid, missing []uint64) chain.Store {
+	t.Helper()
+	ctx := context.Background()
+	store := memdb.NewStore(test.Logger(t), int(n)+1)
+	skip := make(map[uint64]bool)
+	for _, r := range missing {
+		skip[r] = true
+	}
+	for i := uint64(0); i <= n; i++ {
+		if skip[i] {
+			continue
+		}
+		b, err := src.Get(ctx, i)
+		require.NoError(t, err)
+		require.NoError(t, store.Put(ctx, &chain.Beacon{Round: b.Round, Signature: b.Signature, PreviousSig: b.PreviousSig}))
+	}
+	for _, r := range invalid {
+		corrupt(t, store, r)
+	}
+	return store
+}
+
+func corrupt(t *testing.T, store chain.Store, round uint64) {
+	t.Helper()
+	ctx := context.Background()
+	b, err := store.Get(ctx, round)
+	require.NoError(t, err)
+	require.NoError(t, store.Put(ctx, &chain.Beacon{Round: round, Signature: []byte("not a signature"), PreviousSig: b.PreviousSig}))
+}
+
+func TestCheckPastBeacons(t *testing.T) {
+	ctx := context.Background()
+	l := test.Logger(t)
+	n := uint64(50)
+	info, src := newAuditorTestChain(t, n)
+
+	prevSize, prevWorkers := checkChunkSize, checkWorkers
+	checkChunkSize, checkWorkers = 7, 3
+	defer func() { checkChunkSize, checkWorkers = prevSize, prevWorkers }()
+
+	// the invalid round 15 starts a chunk, the missing one is in the middle
+	store := newCheckTestStore(t, src, n, []uint64{10, 15}, []uint64{20})
+	syncm := NewSyncManager(&SyncConfig{
+		Log:   l,
+		Clock: clock.NewFakeClock(),
+		Store: store,
+		Info:  info,
+	})
+
+	var progress []uint64
+	faulty, err := syncm.CheckPastBeacons(ctx, 0, func(r, u uint64) {
+		require.Equal(t, n, u)
+		progress = append(progress, r)
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{10, 15, 20}, faulty)
+	// the progress is reported in order, at the end of each chunk
+	require.Equal(t, []uint64{7, 14, 21, 28, 35, 42, 49, 50}, progress)
+
+	faulty, err = syncm.CheckPastBeacons(ctx, 12, nil)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{10}, faulty)
+
+	valid := newCheckTestStore(t, src, n, nil, nil)
+	syncm = NewSyncManager(&SyncConfig{
+		Log:   l,
+		Clock: clock.NewFakeClock(),
+		Store: valid,
+		Info:  info,
+	})
+	faulty, err = syncm.CheckPastBeacons(ctx, 100, nil)
+	require.NoError(t, err)
+	require.Empty(t, faulty)
+}
+
+func TestCheckPastBeaconsResume(t *testing.T) {
+	ctx := context.Background()
+	l := test.Logger(t)
+	n := uint64(50)
+	info, src := newAuditorTestChain(t, n)
+
+	prevSize, prevWorkers := checkChunkSize, checkWorkers
+	checkChunkSize, checkWorkers = 7, 1
+	defer func() { checkChunkSize, checkWorkers = prevSize, prevWorkers }()
+
+	store := newCheckTestStore(t, src, n, []uint64{10, 15, 40}, []uint64{20})
+	checkpoint := filepath.Join(t.TempDir(), "db", "check.checkpoint")
+	syncm := NewSyncManager(&SyncConfig{
+		Log:            l,
+		Clock:          clock.NewFakeClock(),
+		Store:          store,
+		Info:           info,
+		CheckpointFile: checkpoint,
+	})
+
+	// the check is interrupted after a few chunks
+	cctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	_, err := syncm.CheckPastBeacons(cctx, 0, func(r, u uint64) {
+		if r >= 21 {
+			cancel()
+		}
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	hash := hex.EncodeToString(info.Hash())
+	cp := syncm.loadCheckpoint(hash)
+	require.GreaterOrEqual(t, cp.Verified, uint64(21))
+	require.Less(t, cp.Verified, uint64(40))
+	require.Equal(t, []uint64{10, 15, 20}, cp.Faulty)
+
+	// the rounds before the checkpoint aren't checked again, but the faulty
+	// ones are: round 10 got corrected in the meantime
+	corrupt(t, store, 5)
+	b, err := src.Get(ctx, 10)
+	require.NoError(t, err)
+	require.NoError(t, store.Put(ctx, b))
+
+	faulty, err := syncm.CheckPastBeacons(ctx, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{15, 20, 40}, faulty)
+
+	// the checkpoint is removed once the check completed
+	_, err = os.Stat(checkpoint)
+	require.ErrorIs(t, err, os.ErrNotExist)
+	faulty, err = syncm.CheckPastBeacons(ctx, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{5, 15, 20, 40}, faulty)
+
+	// a checkpoint of another chain is ignored
+	syncm.saveCheckpoint(&checkCheckpoint{ChainHash: "deadbeef", Verified: n})
+	faulty, err = syncm.CheckPastBeacons(ctx, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{5, 15, 20, 40}, faulty)
+}
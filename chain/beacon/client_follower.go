@@ -0,0 +1,186 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	cl "github.com/jonboulle/clockwork"
+
+	"github.com/drand/drand/chain"
+	"github.com/drand/drand/client"
+	"github.com/drand/drand/log"
+)
+
+// ErrInvalidClientBeacon is returned when the source of a ClientFollower
+// serves a beacon that doesn't verify
+var ErrInvalidClientBeacon = errors.New("invalid beacon from the source")
+
+// ClientFollowConfig holds the parameters of a ClientFollower
+type ClientFollowConfig struct {
+	Log   log.Logger
+	Clock cl.Clock
+	// Client is the source of the beacons: http relays, the public gRPC API of
+	// a node, gossip relays or any other client of the chain
+	Client client.Client
+	// Store is where the verified beacons are appended. It must hold at least
+	// the genesis beacon.
+	Store chain.Store
+	Info  *chain.Info
+}
+
+// ClientFollower follows a chain from a drand client rather than from the
+// private API of its nodes, so that a node can follow a public network
+// without the group members allowing it. It backfills the rounds it misses
+// with Get and then tails the chain with Watch, verifying every beacon against
+// the chain info before storing it.
+type ClientFollower struct {
+	log      log.Logger
+	clock    cl.Clock
+	client   client.Client
+	store    chain.Store
+	info     *chain.Info
+	verifier *chain.Verifier
+}
+
+// NewClientFollower returns a follower appending the beacons of the client to
+// the store
+func NewClientFollower(c *ClientFollowConfig) *ClientFollower {
+	return &ClientFollower{
+		log:      c.Log.Named("ClientFollower"),
+		clock:    c.Clock,
+		client:   c.Client,
+		store:    c.Store,
+		info:     c.Info,
+		verifier: chain.NewVerifier(c.Info.Scheme),
+	}
+}
+
+// Follow appends the beacons of the client to the store until the round upTo
+// is stored, or forever if upTo is 0. It only returns early when the context
+// is canceled or the client serves an invalid beacon: the other errors of the
+// client are retried after a period.
+func (f *ClientFollower) Follow(ctx context.Context, upTo uint64) error {
+	last, err := f.store.Last(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to fetch the last stored beacon: %w", err)
+	}
+
+	for {
+		if upTo > 0 && last.Round >= upTo {
+			return nil
+		}
+
+		target := chain.CurrentRound(f.clock.Now().Unix(), f.info.Period, f.info.GenesisTime)
+		if upTo > 0 && upTo < target {
+			target = upTo
+		}
+		last, err = f.backfill(ctx, last, target)
+		if err == nil {
+			last, err = f.tail(ctx, last, upTo)
+		}
+		switch {
+		case err == nil:
+			continue
+		case ctx.Err() != nil:
+			return ctx.Err()
+		case errors.Is(err, ErrInvalidClientBeacon):
+			return err
+		}
+
+		f.log.Warnw("following from the client failed, retrying", "last_round", last.Round, "err", err)
+		select {
+		case <-f.clock.After(f.info.Period):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// backfill fetches and appends the rounds after last up to the target round.
+// It returns the last beacon appended.
+func (f *ClientFollower) backfill(ctx context.Context, last *chain.Beacon, target uint64) (*chain.Beacon, error) {
+	if last.Round < target {
+		f.log.Infow("backfilling from the client", "from_round", last.Round+1, "up_to", target)
+	}
+	for r := last.Round + 1; r <= target; r++ {
+		res, err := f.client.Get(ctx, r)
+		if err != nil {
+			return last, fmt.Errorf("unable to get round %d: %w", r, err)
+		}
+		if res.Round() != r {
+			return last, fmt.Errorf("%w: asked round %d, got %d", ErrInvalidClientBeacon, r, res.Round())
+		}
+		b, err := f.append(ctx, last, res)
+		if err != nil {
+			return last, err
+		}
+		last = b
+	}
+	return last, nil
+}
+
+// tail appends the new beacons of the client as they come, backfilling the
+// rounds the watch skips. It returns without error once the round upTo is
+// stored, and with an error when the watch ends.
+func (f *ClientFollower) tail(ctx context.Context, last *chain.Beacon, upTo uint64) (*chain.Beacon, error) {
+	wctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var err error
+	for res := range f.client.Watch(wctx) {
+		if res.Round() <= last.Round {
+			continue
+		}
+		if upTo > 0 && res.Round() > upTo {
+			return f.backfill(ctx, last, upTo)
+		}
+		if last, err = f.backfill(ctx, last, res.Round()-1); err != nil {
+			return last, err
+		}
+		if last, err = f.append(ctx, last, res); err != nil {
+			return last, err
+		}
+		if upTo > 0 && last.Round >= upTo {
+			return last, nil
+		}
+	}
+	return last, errors.New("watch ended")
+}
+
+// append verifies the result as the beacon following last and stores it
+func (f *ClientFollower) append(ctx context.Context, last *chain.Beacon, res client.Result) (*chain.Beacon, error) {
+	b := &chain.Beacon{
+		Round:     res.Round(),
+		Signature: res.Signature(),
+	}
+	if f.verifier.IsPrevSigMeaningful() {
+		// the clients don't always give the previous signature, we link the
+		// beacon to the one we stored instead
+		if prev := previousSignature(res); len(prev) > 0 && !bytes.Equal(prev, last.Signature) {
+			return last, fmt.Errorf("%w: round %d doesn't link to the previous one", ErrInvalidClientBeacon, b.Round)
+		}
+		b.PreviousSig = last.Signature
+	}
+	if err := f.verifier.VerifyBeacon(*b, f.info.PublicKey); err != nil {
+		return last, fmt.Errorf("%w: round %d: %v", ErrInvalidClientBeacon, b.Round, err)
+	}
+	if err := f.store.Put(ctx, b); err != nil {
+		return last, fmt.Errorf("unable to store round %d: %w", b.Round, err)
+	}
+	return b, nil
+}
+
+// previousSignature returns the previous signature of the result, if the
+// client gives it
+func previousSignature(res client.Result) []byte {
+	switch r := res.(type) {
+	case *client.RandomData:
+		return r.PreviousSignature
+	case interface{ PreviousSignature() []byte }:
+		return r.PreviousSignature()
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,168 @@
+package beacon
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	clock "github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/chain"
+	"github.com/drand/drand/chain/memdb"
+	"github.com/drand/drand/client"
+	"github.com/drand/drand/test"
+)
+
+// storeClient serves the beacons of a store, the new ones being pushed on its
+// watch channel by the tests
+type storeClient struct {
+	src   chain.Store
+	info  *chain.Info
+	watch chan client.Result
+	// rounds served with an invalid signature
+	bad map[uint64]bool
+
+	mu sync.Mutex
+	// Get fails that many times for these rounds
+	fail map[uint64]int
+}
+
+func (c *storeClient) Get(ctx context.Context, round uint64) (client.Result, error) {
+	c.mu.Lock()
+	if c.fail[round] > 0 {
+		c.fail[round]--
+		c.mu.Unlock()
+		return nil, errors.New("relay unavailable")
+	}
+	c.mu.Unlock()
+
+	b, err := c.src.Get(ctx, round)
+	if err != nil {
+		return nil, err
+	}
+	return c.result(b), nil
+}
+
+func (c *storeClient) result(b *chain.Beacon) *client.RandomData {
+	rd := &client.RandomData{
+		Rnd:               b.Round,
+		Random:            b.Randomness(),
+		Sig:               b.Signature,
+		PreviousSignature: b.PreviousSig,
+	}
+	if c.bad[b.Round] {
+		rd.Sig = []byte("not a signature")
+	}
+	return rd
+}
+
+func (c *storeClient) Watch(ctx context.Context) <-chan client.Result {
+	return c.watch
+}
+
+func (c *storeClient) Info(ctx context.Context) (*chain.Info, error) {
+	return c.info, nil
+}
+
+func (c *storeClient) RoundAt(t time.Time) uint64 {
+	return chain.CurrentRound(t.Unix(), c.info.Period, c.info.GenesisTime)
+}
+
+func (c *storeClient) Close() error {
+	return nil
+}
+
+func TestClientFollower(t *testing.T) {
+	ctx := context.Background()
+	l := test.Logger(t)
+	n := uint64(30)
+	info, src := newAuditorTestChain(t, n)
+
+	// the store refuses beacons out of order
+	mem := memdb.NewStore(l, int(n)+1)
+	genesis, err := src.Get(ctx, 0)
+	require.NoError(t, err)
+	require.NoError(t, mem.Put(ctx, genesis))
+
+	// the chain is at round 6
+	cl := clock.NewFakeClockAt(time.Unix(info.GenesisTime+5, 0))
+	c := &storeClient{
+		src:   src,
+		info:  info,
+		watch: make(chan client.Result),
+		fail:  map[uint64]int{4: 1},
+	}
+	follower := NewClientFollower(&ClientFollowConfig{
+		Log:    l,
+		Clock:  cl,
+		Client: c,
+		Store:  NewSchemeStore(newAppendStore(mem), info.Scheme),
+		Info:   info,
+	})
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- follower.Follow(ctx, 20) }()
+
+	// the round the relay failed to serve is fetched again after a period
+	cl.BlockUntil(1)
+	cl.Advance(info.Period)
+
+	get := func(round uint64) client.Result {
+		b, err := src.Get(ctx, round)
+		require.NoError(t, err)
+		return c.result(b)
+	}
+	// the rounds the watch skips are backfilled, the old ones are ignored
+	c.watch <- get(9)
+	c.watch <- get(7)
+	c.watch <- get(10)
+	// the rounds after upTo are not stored
+	c.watch <- get(25)
+	require.NoError(t, <-errCh)
+
+	for i := uint64(1); i <= 20; i++ {
+		expected, err := src.Get(ctx, i)
+		require.NoError(t, err)
+		b, err := mem.Get(ctx, i)
+		require.NoError(t, err)
+		require.True(t, expected.Equal(b), "round %d", i)
+	}
+	last, err := mem.Last(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(20), last.Round)
+}
+
+func TestClientFollowerInvalidBeacon(t *testing.T) {
+	ctx := context.Background()
+	l := test.Logger(t)
+	n := uint64(10)
+	info, src := newAuditorTestChain(t, n)
+
+	mem := memdb.NewStore(l, int(n)+1)
+	genesis, err := src.Get(ctx, 0)
+	require.NoError(t, err)
+	require.NoError(t, mem.Put(ctx, genesis))
+
+	c := &storeClient{
+		src:   src,
+		info:  info,
+		watch: make(chan client.Result),
+		bad:   map[uint64]bool{4: true},
+	}
+	follower := NewClientFollower(&ClientFollowConfig{
+		Log:    l,
+		Clock:  clock.NewFakeClockAt(time.Unix(info.GenesisTime+int64(n), 0)),
+		Client: c,
+		Store:  mem,
+		Info:   info,
+	})
+
+	// the beacons are verified before being stored
+	require.ErrorIs(t, follower.Follow(ctx, n), ErrInvalidClientBeacon)
+	last, err := mem.Last(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), last.Round)
+}
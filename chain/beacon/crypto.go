@@ -55,7 +55,18 @@ func (c *cryptoStore) GetPub() *share.PubPoly {
 func (c *cryptoStore) SignPartial(msg []byte) ([]byte, error) {
 	c.Lock()
 	defer c.Unlock()
-	return key.Scheme.Sign(c.share.PrivateShare(), msg)
+	return c.crypto().ThresholdScheme.Sign(c.share.PrivateShare(), msg)
+}
+
+// GetCrypto returns the groups and signature schemes of the beacon scheme
+func (c *cryptoStore) GetCrypto() *key.Crypto {
+	c.Lock()
+	defer c.Unlock()
+	return c.crypto()
+}
+
+func (c *cryptoStore) crypto() *key.Crypto {
+	return key.CryptoFor(c.chain.Scheme)
 }
 
 // Index returns the index of the share
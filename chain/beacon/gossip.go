@@ -0,0 +1,136 @@
+package beacon
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/drand/drand/key"
+	proto "github.com/drand/drand/protobuf/drand"
+)
+
+// gossip keeps track of the partials relayed when the group disseminates them
+// through an overlay, in which each node only sends the partials to a few
+// peers. Each node relays the valid partials it sees for the first time, until
+// it aggregated the beacon of their round: by then it has relayed at least a
+// threshold of them, enough for its peers to aggregate the beacon as well.
+type gossip struct {
+	sync.Mutex
+	// the partials of the rounds up to this one aren't relayed anymore
+	aggregated uint64
+	// the partials already relayed for each round
+	seen map[uint64]map[relayed]bool
+}
+
+// relayed identifies a partial within its round, the previous signature
+// telling apart the partials of a chained round signed over different beacons
+type relayed struct {
+	idx  int
+	prev string
+}
+
+func newGossip() *gossip {
+	return &gossip{
+		seen: make(map[uint64]map[relayed]bool),
+	}
+}
+
+// Seen returns true if the partial was already relayed, or if its round is
+// already aggregated: in both cases there's nothing left to do with it.
+func (g *gossip) Seen(p *proto.PartialBeaconPacket, idx int) bool {
+	g.Lock()
+	defer g.Unlock()
+	if p.GetRound() <= g.aggregated {
+		return true
+	}
+	return g.seen[p.GetRound()][relayed{idx: idx, prev: string(p.GetPreviousSig())}]
+}
+
+// ShouldRelay returns true if the partial of the given index must be relayed,
+// i.e. the first time it is seen for a round not aggregated yet.
+func (g *gossip) ShouldRelay(p *proto.PartialBeaconPacket, idx int) bool {
+	round := p.GetRound()
+
+	g.Lock()
+	defer g.Unlock()
+	if round <= g.aggregated {
+		return false
+	}
+	// the rounds that are never aggregated must not pile up
+	if limit := uint64(partialCacheStoreLimit + 1); round > limit {
+		for r := range g.seen {
+			if r < round-limit {
+				delete(g.seen, r)
+			}
+		}
+	}
+	seen, ok := g.seen[round]
+	if !ok {
+		seen = make(map[relayed]bool)
+		g.seen[round] = seen
+	}
+	id := relayed{idx: idx, prev: string(p.GetPreviousSig())}
+	if seen[id] {
+		return false
+	}
+	seen[id] = true
+	return true
+}
+
+// Aggregated stops relaying the partials of the rounds up to the given one
+func (g *gossip) Aggregated(round uint64) {
+	g.Lock()
+	defer g.Unlock()
+	if round <= g.aggregated {
+		return
+	}
+	g.aggregated = round
+	for r := range g.seen {
+		if r <= round {
+			delete(g.seen, r)
+		}
+	}
+}
+
+// gossipPeers returns the nodes the node of the given index sends partials to,
+// given the fanout of the group. Taking the nodes ordered by index as a ring,
+// these are the nodes 1, 2, 4, ... positions after it, then the closest ones
+// left. The overlay is connected whatever the fanout, and a partial reaches
+// every node in log2(n) hops when the fanout is at least log2(n).
+func gossipPeers(group *key.Group, idx int) []*key.Node {
+	nodes := make([]*key.Node, len(group.Nodes))
+	copy(nodes, group.Nodes)
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Index < nodes[j].Index })
+
+	self := -1
+	for i, n := range nodes {
+		if int(n.Index) == idx {
+			self = i
+		}
+	}
+	n := len(nodes)
+	fanout := group.GossipFanout
+	if self < 0 || fanout <= 0 {
+		return nil
+	}
+	if fanout > n-1 {
+		fanout = n - 1
+	}
+
+	offsets := make([]int, 0, fanout)
+	picked := make(map[int]bool)
+	for o := 1; o < n && len(offsets) < fanout; o *= 2 {
+		offsets = append(offsets, o)
+		picked[o] = true
+	}
+	for o := 1; o < n && len(offsets) < fanout; o++ {
+		if !picked[o] {
+			offsets = append(offsets, o)
+		}
+	}
+
+	peers := make([]*key.Node, 0, len(offsets))
+	for _, o := range offsets {
+		peers = append(peers, nodes[(self+o)%n])
+	}
+	return peers
+}
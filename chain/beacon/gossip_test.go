@@ -0,0 +1,86 @@
+package beacon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/common/scheme"
+	"github.com/drand/drand/key"
+	"github.com/drand/drand/protobuf/drand"
+	"github.com/drand/drand/test"
+)
+
+func TestGossipPeers(t *testing.T) {
+	_, group := test.BatchIdentities(10, scheme.GetSchemeFromEnv(), "gossip_test")
+	indexes := func(nodes []*key.Node) []int {
+		var idx []int
+		for _, n := range nodes {
+			idx = append(idx, int(n.Index))
+		}
+		return idx
+	}
+
+	group.GossipFanout = 0
+	require.Empty(t, gossipPeers(group, 0))
+
+	group.GossipFanout = 3
+	require.Equal(t, []int{1, 2, 4}, indexes(gossipPeers(group, 0)))
+	require.Equal(t, []int{9, 0, 2}, indexes(gossipPeers(group, 8)))
+	require.Empty(t, gossipPeers(group, 42))
+
+	group.GossipFanout = 5
+	require.Equal(t, []int{1, 2, 4, 8, 3}, indexes(gossipPeers(group, 0)))
+
+	group.GossipFanout = 20
+	require.Len(t, gossipPeers(group, 3), 9)
+
+	// every node is reached from any other, within log2(n) hops when the
+	// fanout allows it
+	for fanout, maxHops := range map[int]int{1: 9, 2: 5, 4: 4} {
+		group.GossipFanout = fanout
+		for _, from := range group.Nodes {
+			hops := map[int]int{int(from.Index): 0}
+			queue := []int{int(from.Index)}
+			for len(queue) > 0 {
+				idx := queue[0]
+				queue = queue[1:]
+				for _, peer := range gossipPeers(group, idx) {
+					if _, ok := hops[int(peer.Index)]; !ok {
+						hops[int(peer.Index)] = hops[idx] + 1
+						queue = append(queue, int(peer.Index))
+					}
+				}
+			}
+			require.Len(t, hops, group.Len(), "fanout %d", fanout)
+			for _, h := range hops {
+				require.LessOrEqual(t, h, maxHops, "fanout %d", fanout)
+			}
+		}
+	}
+}
+
+func TestGossipRelay(t *testing.T) {
+	g := newGossip()
+	p := &drand.PartialBeaconPacket{Round: 10, PreviousSig: []byte("prev")}
+	other := &drand.PartialBeaconPacket{Round: 10, PreviousSig: []byte("other prev")}
+
+	require.False(t, g.Seen(p, 1))
+	require.True(t, g.ShouldRelay(p, 1))
+	require.True(t, g.Seen(p, 1))
+	require.False(t, g.ShouldRelay(p, 1))
+	// another node, or another previous signature, is another partial
+	require.True(t, g.ShouldRelay(p, 2))
+	require.True(t, g.ShouldRelay(other, 1))
+
+	// the partials of old rounds are forgotten as new rounds come
+	require.True(t, g.ShouldRelay(&drand.PartialBeaconPacket{Round: 20}, 1))
+	require.False(t, g.Seen(p, 1))
+
+	// nothing is relayed anymore once the round is aggregated
+	g.Aggregated(20)
+	require.True(t, g.Seen(&drand.PartialBeaconPacket{Round: 19}, 3))
+	require.False(t, g.ShouldRelay(&drand.PartialBeaconPacket{Round: 20}, 3))
+	require.True(t, g.ShouldRelay(&drand.PartialBeaconPacket{Round: 21}, 3))
+	require.Empty(t, g.seen[20])
+}
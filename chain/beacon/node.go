@@ -5,6 +5,8 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 	"time"
@@ -31,6 +33,26 @@ type Config struct {
 	Group *key.Group
 	// Clock to use - useful to testing
 	Clock clock.Clock
+	// Retention tells which rounds the node keeps in its database
+	Retention RetentionPolicy
+	// AuditInterval is how often the database is checked for missing or
+	// invalid rounds to fetch again from the group, 0 disables the audit
+	AuditInterval time.Duration
+	// StorePartials keeps the partial signatures recovered into each beacon
+	// aggregated by this node along with its contributors
+	StorePartials bool
+	// BatchVerifyPartials defers the verification of the incoming partials to
+	// the aggregation, where the partials of a round are verified together
+	BatchVerifyPartials bool
+	// Reputation records the offenses of the peers, to sync from the well
+	// behaved ones first. It is shared by the successive handlers of a beacon.
+	Reputation *Reputation
+	// CheckpointFile is where a check of the chain saves its progress to
+	// resume it after an interruption, empty to disable it
+	CheckpointFile string
+	// PauseFile records that the node is paused, so that it stays paused
+	// after a restart or a resharing, empty to only keep it in memory
+	PauseFile string
 }
 
 // Handler holds the logic to initiate, and react to the tBLS protocol. Each time
@@ -55,6 +77,7 @@ type Handler struct {
 	running bool
 	serving bool
 	stopped bool
+	paused  bool
 	version commonutils.Version
 	l       log.Logger
 }
@@ -77,6 +100,9 @@ func NewHandler(c net.ProtocolClient, s chain.Store, conf *Config, l log.Logger,
 		return nil, err
 	}
 
+	// old rounds are deleted according to the retention policy, if any
+	s = NewPruningStore(l, s, crypto.chain, conf.Retention, conf.Clock)
+
 	ticker := newTicker(conf.Clock, conf.Group.Period, conf.Group.GenesisTime)
 	store := newChainStore(l, conf, c, crypto, s, ticker)
 	verifier := chain.NewVerifier(conf.Group.Scheme)
@@ -90,9 +116,13 @@ func NewHandler(c net.ProtocolClient, s chain.Store, conf *Config, l log.Logger,
 		ticker:   ticker,
 		addr:     addr,
 		close:    make(chan bool),
+		paused:   isPaused(conf.PauseFile),
 		l:        l,
 		version:  version,
 	}
+	if handler.paused {
+		l.Infow("beacon handler paused", "pause_file", conf.PauseFile)
+	}
 	return handler, nil
 }
 
@@ -107,17 +137,10 @@ func (h *Handler) ProcessPartialBeacon(c context.Context, p *proto.PartialBeacon
 	nextRound, _ := chain.NextRound(h.conf.Clock.Now().Unix(), h.conf.Group.Period, h.conf.Group.GenesisTime)
 	currentRound := nextRound - 1
 
-	// we allow one round off in the future because of small clock drifts
-	// possible, if a node receives a packet very fast just before his local
-	// clock passed to the next round
-	if p.GetRound() > nextRound {
-		h.l.Errorw("", "process_partial", addr, "invalid_future_round", p.GetRound(), "current_round", currentRound)
-		return nil, fmt.Errorf("invalid round: %d instead of %d", p.GetRound(), currentRound)
-	}
-
 	msg := h.verifier.DigestMessage(p.GetRound(), p.GetPreviousSig())
 
-	idx, _ := key.Scheme.IndexOf(p.GetPartialSig())
+	sch := h.crypto.GetCrypto()
+	idx, _ := sch.ThresholdScheme.IndexOf(p.GetPartialSig())
 	if idx < 0 {
 		return nil, fmt.Errorf("invalid index %d in partial with msg %v", idx, msg)
 	}
@@ -129,16 +152,37 @@ func (h *Handler) ProcessPartialBeacon(c context.Context, p *proto.PartialBeacon
 	}
 
 	nodeName := node.Address()
-	// verify if request is valid
-	if err := key.Scheme.VerifyPartial(h.crypto.GetPub(), msg, p.GetPartialSig()); err != nil {
-		h.l.Errorw("",
-			"process_partial", addr, "err", err,
-			"prev_sig", shortSigStr(p.GetPreviousSig()),
-			"curr_round", currentRound,
-			"msg_sign", shortSigStr(msg),
-			"from_idx", idx,
-			"from_node", nodeName)
-		return nil, err
+
+	// we allow one round off in the future because of small clock drifts
+	// possible, if a node receives a packet very fast just before his local
+	// clock passed to the next round
+	if p.GetRound() > nextRound {
+		h.l.Errorw("", "process_partial", addr, "invalid_future_round", p.GetRound(), "current_round", currentRound)
+		return nil, fmt.Errorf("invalid round: %d instead of %d", p.GetRound(), currentRound)
+	}
+	// partials are relayed through the gossip overlay only once verified, and
+	// each partial reaches the node through several of its peers
+	gossip := h.crypto.GetGroup().GossipFanout > 0
+	if gossip && h.chain.gossip.Seen(p, idx) {
+		return new(proto.Empty), nil
+	}
+	// verify if request is valid, unless it is verified later on along with the
+	// other partials of its round
+	if !h.conf.BatchVerifyPartials || gossip {
+		if err := sch.ThresholdScheme.VerifyPartial(h.crypto.GetPub(), msg, p.GetPartialSig()); err != nil {
+			h.l.Errorw("",
+				"process_partial", addr, "err", err,
+				"prev_sig", shortSigStr(p.GetPreviousSig()),
+				"curr_round", currentRound,
+				"msg_sign", shortSigStr(msg),
+				"from_idx", idx,
+				"from_node", nodeName)
+			// anyone can send a partial claiming the index of any node, so
+			// invalid partials aren't held against the node of that index
+			return nil, err
+		}
+		// only that node could produce a valid partial for its index
+		h.conf.Reputation.Reward(nodeName)
 	}
 	h.l.Debugw("",
 		"process_partial", addr,
@@ -148,6 +192,10 @@ func (h *Handler) ProcessPartialBeacon(c context.Context, p *proto.PartialBeacon
 		"from_node", nodeName,
 		"status", "OK")
 	if idx == h.crypto.Index() {
+		if gossip {
+			// our own partial comes back through the overlay
+			return new(proto.Empty), nil
+		}
 		h.l.Errorw("",
 			"process_partial", addr,
 			"index_got", idx,
@@ -157,10 +205,24 @@ func (h *Handler) ProcessPartialBeacon(c context.Context, p *proto.PartialBeacon
 		// XXX error or not ?
 		return new(proto.Empty), nil
 	}
-	h.chain.NewValidPartial(addr, p)
+	h.chain.partials.Arrived(idx, nodeName, p.GetRound(), h.conf.Clock.Now())
+	if gossip && h.chain.gossip.ShouldRelay(p, idx) {
+		h.sendPartial(p, idx)
+	}
+	if h.conf.BatchVerifyPartials && !gossip {
+		h.chain.NewUnverifiedPartial(addr, p)
+	} else {
+		h.chain.NewValidPartial(addr, p)
+	}
 	return new(proto.Empty), nil
 }
 
+// PartialStatus summarizes the partial signatures received from each group
+// member over the most recent rounds
+func (h *Handler) PartialStatus() []*proto.PeerPartialStatus {
+	return h.chain.partials.Status()
+}
+
 // Store returns the store associated with this beacon handler
 func (h *Handler) Store() CallbackStore {
 	return h.chain
@@ -282,6 +344,84 @@ func (h *Handler) IsStopped() bool {
 	return h.stopped
 }
 
+func (h *Handler) IsPaused() bool {
+	h.Lock()
+	defer h.Unlock()
+
+	return h.paused
+}
+
+// Pause stops the node from sending its partial signatures until Resume is
+// called. The node keeps aggregating the partials it receives, syncing and
+// serving its chain meanwhile.
+func (h *Handler) Pause() error {
+	h.Lock()
+	defer h.Unlock()
+	if h.stopped {
+		return errors.New("beacon: handler is stopped")
+	}
+	if h.paused {
+		return errors.New("beacon: handler is already paused")
+	}
+	if h.conf.PauseFile != "" {
+		if err := os.MkdirAll(filepath.Dir(h.conf.PauseFile), 0o700); err != nil {
+			return fmt.Errorf("beacon: can't record the pause: %w", err)
+		}
+		if err := os.WriteFile(h.conf.PauseFile, nil, 0o600); err != nil {
+			return fmt.Errorf("beacon: can't record the pause: %w", err)
+		}
+	}
+
+	h.paused = true
+	h.l.Infow("beacon handler paused", "time", h.conf.Clock.Now())
+	return nil
+}
+
+// Resume makes a paused node catch up with the chain and send its partial
+// signatures again from the next round. Unlike Catchup, it doesn't start a
+// beacon loop: the loop of a paused node keeps running, syncing the chain and
+// aggregating the partials of the other nodes, so a sync up to the current
+// round is enough for the next tick to build on the last beacon.
+func (h *Handler) Resume() error {
+	h.Lock()
+	if h.stopped {
+		h.Unlock()
+		return errors.New("beacon: handler is stopped")
+	}
+	if !h.paused {
+		h.Unlock()
+		return errors.New("beacon: handler is not paused")
+	}
+	if h.conf.PauseFile != "" {
+		if err := os.Remove(h.conf.PauseFile); err != nil && !errors.Is(err, os.ErrNotExist) {
+			h.Unlock()
+			return fmt.Errorf("beacon: can't record the resume: %w", err)
+		}
+	}
+	h.paused = false
+	started := h.started
+	h.Unlock()
+
+	h.l.Infow("beacon handler resumed", "time", h.conf.Clock.Now())
+	if started {
+		// the beacon loop kept running, we only make sure the node is up to
+		// date before it sends its next partial. A node that isn't started
+		// yet catches up when it is.
+		nRound, _ := chain.NextRound(h.conf.Clock.Now().Unix(), h.conf.Group.Period, h.conf.Group.GenesisTime)
+		h.chain.RunSync(nRound, nil)
+	}
+	return nil
+}
+
+// isPaused tells whether the pause file records that the node is paused
+func isPaused(pauseFile string) bool {
+	if pauseFile == "" {
+		return false
+	}
+	_, err := os.Stat(pauseFile)
+	return err == nil
+}
+
 func (h *Handler) Reset() {
 	h.Lock()
 	defer h.Unlock()
@@ -366,7 +506,10 @@ func (h *Handler) run(startTime int64) {
 }
 
 func (h *Handler) broadcastNextPartial(current roundInfo, upon *chain.Beacon) {
-	ctx := context.Background()
+	if h.IsPaused() {
+		// the loop keeps running while the node is paused, to sync the chain
+		return
+	}
 	previousSig := upon.Signature
 	round := upon.Round + 1
 	beaconID := commonutils.GetCanonicalBeaconID(h.conf.Group.ID)
@@ -399,9 +542,24 @@ func (h *Handler) broadcastNextPartial(current roundInfo, upon *chain.Beacon) {
 	}
 
 	h.chain.NewValidPartial(h.addr, packet)
-	for _, id := range h.crypto.GetGroup().Nodes {
+	h.chain.gossip.ShouldRelay(packet, h.crypto.Index())
+	h.sendPartial(packet, h.crypto.Index())
+}
+
+// sendPartial sends the partial of the node of the given index to the other
+// nodes: to all of them, or only to the gossip peers of this node when the
+// group disseminates the partials through gossip.
+func (h *Handler) sendPartial(packet *proto.PartialBeaconPacket, from int) {
+	ctx := context.Background()
+	round := packet.GetRound()
+	group := h.crypto.GetGroup()
+	nodes := group.Nodes
+	if group.GossipFanout > 0 {
+		nodes = gossipPeers(group, h.crypto.Index())
+	}
+	for _, id := range nodes {
 		idt := id.Identity
-		if h.addr == id.Address() {
+		if h.addr == id.Address() || int(id.Index) == from {
 			continue
 		}
 		go func(i *key.Identity) {
@@ -411,9 +569,13 @@ func (h *Handler) broadcastNextPartial(current roundInfo, upon *chain.Beacon) {
 				h.l.Errorw("", "beacon_round", round, "err_request", err, "from", i.Address())
 				if strings.Contains(err.Error(), errOutOfRound) {
 					h.l.Errorw("", "beacon_round", round, "node", i.Addr, "reply", "out-of-round")
+					h.conf.Reputation.Penalize(i.Address(), OffenseOutOfRound)
+				} else if IsTimeout(err) {
+					h.conf.Reputation.Penalize(i.Address(), OffenseTimeout)
 				}
 				return
 			}
+			h.conf.Reputation.Reward(i.Address())
 		}(idt)
 	}
 }
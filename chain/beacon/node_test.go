@@ -15,6 +15,7 @@ import (
 
 	"github.com/drand/drand/chain"
 	"github.com/drand/drand/chain/boltdb"
+	"github.com/drand/drand/chain/memdb"
 	"github.com/drand/drand/common"
 	"github.com/drand/drand/common/scheme"
 	"github.com/drand/drand/key"
@@ -29,8 +30,6 @@ import (
 	"github.com/drand/kyber/util/random"
 )
 
-// TODO make beacon tests not dependent on key.Scheme
-
 // testBeaconServer implements a barebone service to be plugged in a net.DefaultService
 type testBeaconServer struct {
 	disable bool
@@ -52,13 +51,14 @@ func (t *testBeaconServer) SyncChain(req *drand.SyncRequest, p drand.Protocol_Sy
 	return SyncChain(t.h.l, t.h.chain, req, p)
 }
 
-func dkgShares(_ *testing.T, n, t int) ([]*key.Share, []kyber.Point) {
+func dkgShares(_ *testing.T, n, t int, sch scheme.Scheme) ([]*key.Share, []kyber.Point) {
+	c := key.CryptoFor(sch)
 	var priPoly *share.PriPoly
 	var pubPoly *share.PubPoly
 	var err error
 	for i := 0; i < n; i++ {
-		pri := share.NewPriPoly(key.KeyGroup, t, key.KeyGroup.Scalar().Pick(random.New()), random.New())
-		pub := pri.Commit(key.KeyGroup.Point().Base())
+		pri := share.NewPriPoly(c.KeyGroup, t, c.KeyGroup.Scalar().Pick(random.New()), random.New())
+		pub := pri.Commit(c.KeyGroup.Point().Base())
 		if priPoly == nil {
 			priPoly = pri
 			pubPoly = pub
@@ -74,7 +74,7 @@ func dkgShares(_ *testing.T, n, t int) ([]*key.Share, []kyber.Point) {
 		}
 	}
 	shares := priPoly.Shares(n)
-	secret, err := share.RecoverSecret(key.KeyGroup, shares, t, n)
+	secret, err := share.RecoverSecret(c.KeyGroup, shares, t, n)
 	if err != nil {
 		panic(err)
 	}
@@ -87,7 +87,7 @@ func dkgShares(_ *testing.T, n, t int) ([]*key.Share, []kyber.Point) {
 	_, commits := pubPoly.Info()
 	dkgShares := make([]*key.Share, n)
 	for i := 0; i < n; i++ {
-		sigs[i], err = key.Scheme.Sign(shares[i], msg)
+		sigs[i], err = c.ThresholdScheme.Sign(shares[i], msg)
 		if err != nil {
 			panic(err)
 		}
@@ -96,12 +96,12 @@ func dkgShares(_ *testing.T, n, t int) ([]*key.Share, []kyber.Point) {
 			Commits: commits,
 		}
 	}
-	sig, err := key.Scheme.Recover(pubPoly, msg, sigs, t, n)
+	sig, err := c.ThresholdScheme.Recover(pubPoly, msg, sigs, t, n)
 	if err != nil {
 		panic(err)
 	}
 
-	if err := key.Scheme.VerifyRecovered(pubPoly.Commit(), msg, sig); err != nil {
+	if err := c.ThresholdScheme.VerifyRecovered(pubPoly.Commit(), msg, sig); err != nil {
 		panic(err)
 	}
 	return dkgShares, commits
@@ -138,7 +138,7 @@ type BeaconTest struct {
 func NewBeaconTest(t *testing.T, n, thr int, period time.Duration, genesisTime int64, sch scheme.Scheme, beaconID string) *BeaconTest {
 	prefix := t.TempDir()
 	paths := createBoltStores(prefix, n)
-	shares, commits := dkgShares(t, n, thr)
+	shares, commits := dkgShares(t, n, thr, sch)
 	privs, group := test.BatchIdentities(n, sch, beaconID)
 	group.Threshold = thr
 	group.Period = period
@@ -199,10 +199,11 @@ func (b *BeaconTest) CreateNode(t *testing.T, i int) {
 	}
 	node.clock = clock.NewFakeClockAt(b.time.Now())
 	conf := &Config{
-		Group:  b.group,
-		Public: knode,
-		Share:  keyShare,
-		Clock:  node.clock,
+		Group:     b.group,
+		Public:    knode,
+		Share:     keyShare,
+		Clock:     node.clock,
+		PauseFile: path.Join(b.paths[idx], "beacon.paused"),
 	}
 
 	logger := log.NewLogger(nil, log.LogDebug).Named("BeaconTest").Named(knode.Addr).Named(fmt.Sprint(idx))
@@ -217,9 +218,10 @@ func (b *BeaconTest) CreateNode(t *testing.T, i int) {
 		panic("createNode address mismatch")
 	}
 
-	currSig, err := key.Scheme.Sign(node.handler.conf.Share.PrivateShare(), []byte("hello"))
+	c := key.CryptoFor(b.scheme)
+	currSig, err := c.ThresholdScheme.Sign(node.handler.conf.Share.PrivateShare(), []byte("hello"))
 	checkErr(err)
-	sigIndex, _ := key.Scheme.IndexOf(currSig)
+	sigIndex, _ := c.ThresholdScheme.IndexOf(currSig)
 	if sigIndex != idx {
 		panic("invalid index")
 	}
@@ -328,16 +330,19 @@ func (b *BeaconTest) MoveTime(t *testing.T, timeToMove time.Duration) {
 }
 
 func (b *BeaconTest) StopBeacon(i int) {
-	j := b.searchNode(i)
-	if n, ok := b.nodes[j]; ok {
+	for j, n := range b.nodes {
+		if n.index != i {
+			continue
+		}
 		if !n.started {
 			return
 		}
 		n.listener.Stop(context.Background())
 		n.handler.Stop()
 		n.started = false
+		delete(b.nodes, j)
+		return
 	}
-	delete(b.nodes, j)
 }
 
 func (b *BeaconTest) DisableReception(count int) {
@@ -521,6 +526,123 @@ func TestBeaconSimple(t *testing.T) {
 	checkWait(t, counter)
 }
 
+func TestBeaconBatchVerify(t *testing.T) {
+	n := 4
+	thr := n/2 + 1
+	period := 2 * time.Second
+
+	genesisTime := clock.NewFakeClock().Now().Unix() + 2
+	sch, beaconID := scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv()
+
+	bt := NewBeaconTest(t, n, thr, period, genesisTime, sch, beaconID)
+	verifier := chain.NewVerifier(sch)
+
+	var counter = &sync.WaitGroup{}
+	counter.Add(n)
+	myCallBack := func(b *chain.Beacon) {
+		require.NoError(t, verifier.VerifyBeacon(*b, bt.dpublic))
+		counter.Done()
+	}
+
+	for i := 0; i < n; i++ {
+		bt.nodes[i].handler.conf.BatchVerifyPartials = true
+		bt.CallbackFor(i, myCallBack)
+		bt.ServeBeacon(t, i)
+	}
+
+	bt.StartBeacons(t, n)
+	bt.MoveTime(t, 1*time.Second)
+	bt.MoveTime(t, 1*time.Second)
+	checkWait(t, counter)
+
+	counter.Add(n)
+	bt.MoveTime(t, period)
+	checkWait(t, counter)
+}
+
+func TestBeaconGossip(t *testing.T) {
+	n := 5
+	thr := n/2 + 1
+	period := 2 * time.Second
+
+	genesisTime := clock.NewFakeClock().Now().Unix() + 2
+	sch, beaconID := scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv()
+
+	bt := NewBeaconTest(t, n, thr, period, genesisTime, sch, beaconID)
+	// each node only sends to the next one, so the partials must be relayed
+	// around the ring for the nodes to get a threshold of them
+	bt.group.GossipFanout = 1
+	verifier := chain.NewVerifier(sch)
+
+	var counter = &sync.WaitGroup{}
+	counter.Add(n)
+	myCallBack := func(b *chain.Beacon) {
+		require.NoError(t, verifier.VerifyBeacon(*b, bt.dpublic))
+		counter.Done()
+	}
+
+	for i := 0; i < n; i++ {
+		bt.CallbackFor(i, myCallBack)
+		bt.ServeBeacon(t, i)
+	}
+
+	bt.StartBeacons(t, n)
+	bt.MoveTime(t, 1*time.Second)
+	bt.MoveTime(t, 1*time.Second)
+	checkWait(t, counter)
+
+	counter.Add(n)
+	bt.MoveTime(t, period)
+	checkWait(t, counter)
+}
+
+func TestBeaconShortSig(t *testing.T) {
+	for _, id := range []string{scheme.ShortSigSchemeID, scheme.RFC9380SchemeID} {
+		sch, ok := scheme.GetSchemeByID(id)
+		require.True(t, ok)
+		t.Run(id, func(t *testing.T) {
+			testBeaconShortSig(t, sch)
+		})
+	}
+}
+
+func testBeaconShortSig(t *testing.T, sch scheme.Scheme) {
+	n := 4
+	thr := n/2 + 1
+	period := 2 * time.Second
+
+	genesisTime := clock.NewFakeClock().Now().Unix() + 2
+	beaconID := test.GetBeaconIDFromEnv()
+
+	bt := NewBeaconTest(t, n, thr, period, genesisTime, sch, beaconID)
+	verifier := chain.NewVerifier(sch)
+
+	var counter = &sync.WaitGroup{}
+	counter.Add(n)
+	myCallBack := func(b *chain.Beacon) {
+		require.Len(t, b.Signature, 48)
+		require.Nil(t, b.PreviousSig)
+		require.NoError(t, verifier.VerifyBeacon(*b, bt.dpublic))
+		counter.Done()
+	}
+
+	for i := 0; i < n; i++ {
+		// half of the nodes verify the partials of each round at once
+		bt.nodes[i].handler.conf.BatchVerifyPartials = i%2 == 0
+		bt.CallbackFor(i, myCallBack)
+		bt.ServeBeacon(t, i)
+	}
+
+	bt.StartBeacons(t, n)
+	bt.MoveTime(t, 1*time.Second)
+	bt.MoveTime(t, 1*time.Second)
+	checkWait(t, counter)
+
+	counter.Add(n)
+	bt.MoveTime(t, period)
+	checkWait(t, counter)
+}
+
 func TestBeaconThreshold(t *testing.T) {
 	n := 3
 	thr := n/2 + 1
@@ -601,6 +723,141 @@ func TestBeaconThreshold(t *testing.T) {
 	makeRounds(nRounds, n)
 }
 
+func TestBeaconPauseResume(t *testing.T) {
+	n := 3
+	thr := n/2 + 1
+	period := 2 * time.Second
+
+	offsetGenesis := 2 * time.Second
+	genesisTime := clock.NewFakeClock().Now().Add(offsetGenesis).Unix()
+	sch, beaconID := scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv()
+
+	bt := NewBeaconTest(t, n, thr, period, genesisTime, sch, beaconID)
+	verifier := chain.NewVerifier(sch)
+
+	currentRound := uint64(1)
+	var counter sync.WaitGroup
+	for i := 0; i < n; i++ {
+		bt.CallbackFor(i, func(b *chain.Beacon) {
+			require.NoError(t, verifier.VerifyBeacon(*b, bt.dpublic))
+			if b.Round == currentRound {
+				counter.Done()
+			}
+		})
+		bt.ServeBeacon(t, i)
+	}
+
+	bt.StartBeacons(t, n)
+	counter.Add(n)
+	bt.MoveTime(t, offsetGenesis)
+	checkWait(t, &counter)
+
+	// a paused node keeps getting the beacons made with the partials of the
+	// other nodes
+	paused := bt.nodes[bt.searchNode(0)].handler
+	require.NoError(t, paused.Pause())
+	require.True(t, paused.IsPaused())
+	// its beacon loop keeps running to sync the chain
+	require.True(t, paused.IsRunning())
+	require.Error(t, paused.Pause())
+
+	// the pause is recorded, the node stays paused after a restart
+	conf := *paused.conf
+	restarted, err := NewHandler(net.NewGrpcClient(), memdb.NewStore(test.Logger(t), 10), &conf, test.Logger(t), common.GetAppVersion())
+	require.NoError(t, err)
+	require.True(t, restarted.IsPaused())
+	restarted.Stop()
+
+	currentRound++
+	counter.Add(n)
+	bt.MoveTime(t, period)
+	checkWait(t, &counter)
+
+	// without a threshold of nodes sending their partials, no beacon is made
+	other := bt.nodes[bt.searchNode(1)].handler
+	require.NoError(t, other.Pause())
+	bt.MoveTime(t, period)
+	time.Sleep(500 * time.Millisecond)
+	last, err := bt.nodes[bt.searchNode(2)].handler.Store().Last(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, currentRound, last.Round)
+
+	// the resumed nodes catch up and make the missed beacon at the next round
+	require.NoError(t, paused.Resume())
+	require.NoError(t, other.Resume())
+	require.Error(t, other.Resume())
+	require.False(t, paused.IsPaused())
+	require.False(t, isPaused(paused.conf.PauseFile))
+
+	currentRound++
+	counter.Add(n)
+	bt.MoveTime(t, period)
+	checkWait(t, &counter)
+}
+
+func TestBeaconResumeAfterRestart(t *testing.T) {
+	n := 3
+	thr := n/2 + 1
+	period := 2 * time.Second
+
+	offsetGenesis := 2 * time.Second
+	genesisTime := clock.NewFakeClock().Now().Add(offsetGenesis).Unix()
+	sch, beaconID := scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv()
+
+	bt := NewBeaconTest(t, n, thr, period, genesisTime, sch, beaconID)
+	verifier := chain.NewVerifier(sch)
+
+	currentRound := uint64(1)
+	var counter sync.WaitGroup
+	callback := func(b *chain.Beacon) {
+		require.NoError(t, verifier.VerifyBeacon(*b, bt.dpublic))
+		if b.Round == currentRound {
+			counter.Done()
+		}
+	}
+	for i := 0; i < n; i++ {
+		bt.CallbackFor(i, callback)
+		bt.ServeBeacon(t, i)
+	}
+
+	bt.StartBeacons(t, n)
+	counter.Add(n)
+	bt.MoveTime(t, offsetGenesis)
+	checkWait(t, &counter)
+
+	// the node is paused then restarted, it comes back paused
+	require.NoError(t, bt.nodes[bt.searchNode(0)].handler.Pause())
+	bt.StopBeacon(0)
+
+	currentRound++
+	counter.Add(n - 1)
+	bt.MoveTime(t, period)
+	checkWait(t, &counter)
+
+	// the restarted node syncs the missed round, only count the next one
+	currentRound++
+	bt.CreateNode(t, 0)
+	restarted := bt.nodes[bt.searchNode(0)].handler
+	require.True(t, restarted.IsPaused())
+	bt.CallbackFor(0, callback)
+	bt.ServeBeacon(t, 0)
+	bt.StartBeacon(t, 0, true)
+	require.NoError(t, bt.WaitBeaconToKickoff(t, 0))
+	// 2s because of gRPC default timeouts backoff
+	time.Sleep(2 * time.Second)
+
+	// with another node paused, the beacon needs the partials of the restarted
+	// node once it is resumed
+	require.NoError(t, bt.nodes[bt.searchNode(1)].handler.Pause())
+	require.NoError(t, restarted.Resume())
+	require.False(t, restarted.IsPaused())
+	require.False(t, isPaused(restarted.conf.PauseFile))
+
+	counter.Add(n)
+	bt.MoveTime(t, period)
+	checkWait(t, &counter)
+}
+
 func TestProcessingPartialBeaconWithNonExistentIndexDoesntSegfault(t *testing.T) {
 	bls, _ := scheme.GetSchemeByID(scheme.DefaultSchemeID)
 	bt := NewBeaconTest(t, 3, 2, 30*time.Second, 0, bls, "default")
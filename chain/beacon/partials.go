@@ -0,0 +1,195 @@
+package beacon
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/drand/drand/chain"
+	"github.com/drand/drand/common"
+	"github.com/drand/drand/metrics"
+	proto "github.com/drand/drand/protobuf/drand"
+)
+
+// how many of the most recent rounds the summary of each peer covers
+var partialStatsWindow = 100
+
+// partialTracker follows when the partial signature of each group member
+// arrives. A round is closed once the beacon of the next round is stored, which
+// gives late partials one period to arrive, and the members that didn't send
+// their partial by then missed it.
+type partialTracker struct {
+	sync.Mutex
+	crypto *cryptoStore
+	// the latency of the partials received for each round not closed yet, by
+	// index of their sender
+	open map[uint64]map[int]float64
+	// all the rounds up to closed have been accounted for
+	closed uint64
+	peers  map[int]*peerPartials
+}
+
+// peerPartials is the record of the most recent rounds of one member
+type peerPartials struct {
+	addr      string
+	lastRound uint64
+	// oldest round first, at most partialStatsWindow of them
+	history []partialRecord
+}
+
+type partialRecord struct {
+	missed bool
+	// in milliseconds
+	latency float64
+}
+
+func newPartialTracker(c *cryptoStore) *partialTracker {
+	return &partialTracker{
+		crypto: c,
+		open:   make(map[uint64]map[int]float64),
+		peers:  make(map[int]*peerPartials),
+	}
+}
+
+// Arrived records the partial of the member at the given index for a round,
+// received at the given time. Duplicates and partials of closed rounds are
+// ignored.
+func (t *partialTracker) Arrived(idx int, addr string, round uint64, at time.Time) {
+	group := t.crypto.GetGroup()
+	if idx == t.crypto.Index() {
+		return
+	}
+	expected := time.Unix(chain.TimeOfRound(group.Period, group.GenesisTime, round), 0)
+	latency := float64(at.Sub(expected)) / float64(time.Millisecond)
+
+	t.Lock()
+	defer t.Unlock()
+	if round <= t.closed {
+		return
+	}
+	received, ok := t.open[round]
+	if !ok {
+		received = make(map[int]float64)
+		t.open[round] = received
+	}
+	if _, seen := received[idx]; seen {
+		return
+	}
+	received[idx] = latency
+
+	peer := t.peer(idx, addr)
+	if round > peer.lastRound {
+		peer.lastRound = round
+	}
+	metrics.PartialArrivalLatency.WithLabelValues(common.GetCanonicalBeaconID(group.ID), addr).Observe(latency)
+
+	// rounds that never get a beacon must not pile up
+	if uint64(partialStatsWindow) < round {
+		t.closeRounds(round - uint64(partialStatsWindow))
+	}
+}
+
+// Close accounts for all the rounds up to the given one
+func (t *partialTracker) Close(round uint64) {
+	t.Lock()
+	defer t.Unlock()
+	t.closeRounds(round)
+}
+
+// closeRounds records the outcome of the open rounds up to the given one. Only
+// the rounds for which a partial was received are accounted, so that rounds
+// the node wasn't there for, e.g. during a catchup, don't count as missed.
+// The caller must hold the lock.
+func (t *partialTracker) closeRounds(upTo uint64) {
+	if upTo <= t.closed {
+		return
+	}
+	t.closed = upTo
+
+	group := t.crypto.GetGroup()
+	beaconID := common.GetCanonicalBeaconID(group.ID)
+	self := t.crypto.Index()
+
+	rounds := make([]uint64, 0, len(t.open))
+	for r := range t.open {
+		if r <= upTo {
+			rounds = append(rounds, r)
+		}
+	}
+	sort.Slice(rounds, func(i, j int) bool { return rounds[i] < rounds[j] })
+
+	for _, r := range rounds {
+		received := t.open[r]
+		delete(t.open, r)
+		for _, node := range group.Nodes {
+			idx := int(node.Index)
+			if idx == self {
+				continue
+			}
+			peer := t.peer(idx, node.Address())
+			latency, ok := received[idx]
+			if !ok {
+				metrics.PartialMissed.WithLabelValues(beaconID, node.Address()).Inc()
+			}
+			peer.record(partialRecord{missed: !ok, latency: latency})
+		}
+	}
+}
+
+// peer returns the record of a member, updating its address after a reshare.
+// The caller must hold the lock.
+func (t *partialTracker) peer(idx int, addr string) *peerPartials {
+	peer, ok := t.peers[idx]
+	if !ok || peer.addr != addr {
+		peer = &peerPartials{addr: addr}
+		t.peers[idx] = peer
+	}
+	return peer
+}
+
+func (p *peerPartials) record(r partialRecord) {
+	if len(p.history) == partialStatsWindow {
+		copy(p.history, p.history[1:])
+		p.history = p.history[:len(p.history)-1]
+	}
+	p.history = append(p.history, r)
+}
+
+// Status summarizes the most recent rounds of each member, ordered by index
+func (t *partialTracker) Status() []*proto.PeerPartialStatus {
+	t.Lock()
+	defer t.Unlock()
+
+	indexes := make([]int, 0, len(t.peers))
+	for idx := range t.peers {
+		indexes = append(indexes, idx)
+	}
+	sort.Ints(indexes)
+
+	status := make([]*proto.PeerPartialStatus, 0, len(indexes))
+	for _, idx := range indexes {
+		peer := t.peers[idx]
+		s := &proto.PeerPartialStatus{
+			Address:   peer.addr,
+			Index:     uint32(idx),
+			Rounds:    uint64(len(peer.history)),
+			LastRound: peer.lastRound,
+		}
+		var total float64
+		for _, r := range peer.history {
+			if r.missed {
+				s.Missed++
+				continue
+			}
+			total += r.latency
+			if r.latency > s.MaxLatencyMs {
+				s.MaxLatencyMs = r.latency
+			}
+		}
+		if received := s.Rounds - s.Missed; received > 0 {
+			s.MeanLatencyMs = total / float64(received)
+		}
+		status = append(status, s)
+	}
+	return status
+}
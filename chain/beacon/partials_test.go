@@ -0,0 +1,82 @@
+package beacon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/chain"
+	"github.com/drand/drand/common/scheme"
+	"github.com/drand/drand/key"
+	"github.com/drand/drand/metrics"
+	"github.com/drand/drand/test"
+	"github.com/drand/kyber/share"
+)
+
+func TestPartialTracker(t *testing.T) {
+	_, group := test.BatchIdentities(4, scheme.GetSchemeFromEnv(), "partials_test")
+	// we are the node of index 0
+	crypto := &cryptoStore{group: group, share: &key.Share{Share: &share.PriShare{I: 0}}}
+	tracker := newPartialTracker(crypto)
+
+	addr := func(idx int) string { return group.Node(uint32(idx)).Address() }
+	arrive := func(idx int, round uint64, ms int) {
+		start := time.Unix(chain.TimeOfRound(group.Period, group.GenesisTime, round), 0)
+		tracker.Arrived(idx, addr(idx), round, start.Add(time.Duration(ms)*time.Millisecond))
+	}
+
+	arrive(0, 1, 10)
+	arrive(1, 1, 100)
+	arrive(1, 1, 900)
+	arrive(2, 1, 300)
+	arrive(1, 2, 200)
+	arrive(3, 2, 50)
+
+	tracker.Close(1)
+	// a partial arriving after its round was closed was missed
+	arrive(3, 1, 2000)
+	tracker.Close(2)
+	// closing rounds for which nothing was received doesn't count misses
+	tracker.Close(10)
+
+	status := tracker.Status()
+	require.Len(t, status, 3)
+	expected := []struct {
+		missed    uint64
+		mean, max float64
+		last      uint64
+	}{
+		{0, 150, 200, 2},
+		{1, 300, 300, 1},
+		{1, 50, 50, 2},
+	}
+	for i, s := range status {
+		require.Equal(t, uint32(i+1), s.Index)
+		require.Equal(t, addr(i+1), s.Address)
+		require.Equal(t, uint64(2), s.Rounds)
+		require.Equal(t, expected[i].missed, s.Missed, "index %d", i+1)
+		require.Equal(t, expected[i].mean, s.MeanLatencyMs, "index %d", i+1)
+		require.Equal(t, expected[i].max, s.MaxLatencyMs, "index %d", i+1)
+		require.Equal(t, expected[i].last, s.LastRound, "index %d", i+1)
+	}
+
+	require.Equal(t, float64(0), testutil.ToFloat64(metrics.PartialMissed.WithLabelValues(group.ID, addr(1))))
+	require.Equal(t, float64(1), testutil.ToFloat64(metrics.PartialMissed.WithLabelValues(group.ID, addr(3))))
+
+	// rounds that never get a beacon are closed as newer partials arrive, and
+	// the summary only covers the most recent rounds
+	prev := partialStatsWindow
+	partialStatsWindow = 2
+	defer func() { partialStatsWindow = prev }()
+	arrive(1, 20, 100)
+	arrive(1, 23, 100)
+	status = tracker.Status()
+	require.Equal(t, uint64(2), status[0].Rounds)
+	require.Equal(t, uint64(0), status[0].Missed)
+	require.Equal(t, uint64(23), status[0].LastRound)
+	// rounds 2 and 20
+	require.Equal(t, uint64(2), status[1].Missed)
+	require.Equal(t, uint64(1), status[2].Missed)
+}
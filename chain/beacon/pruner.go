@@ -0,0 +1,233 @@
+package beacon
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	clock "github.com/jonboulle/clockwork"
+
+	"github.com/drand/drand/chain"
+	chainerrors "github.com/drand/drand/chain/errors"
+	"github.com/drand/drand/log"
+)
+
+// RetentionPolicy defines how much of the chain a node keeps in its database.
+// A zero policy keeps the whole chain.
+type RetentionPolicy struct {
+	// Rounds is the number of most recent rounds to keep, 0 means no limit
+	Rounds uint64
+	// MaxAge is the maximum age of the rounds to keep, 0 means no limit
+	MaxAge time.Duration
+}
+
+// Enabled returns true if the policy requires pruning the database
+func (r RetentionPolicy) Enabled() bool {
+	return r.Rounds > 0 || r.MaxAge > 0
+}
+
+// how often the pruner looks for rounds to delete
+var pruneInterval = time.Minute
+
+// how many rounds the pruner deletes before checking if it should stop
+var pruneBatchSize uint64 = 1000
+
+// pruningStore deletes in the background the rounds falling outside of its
+// retention policy. Rounds below its watermark are reported as pruned instead
+// of missing. The genesis beacon is never deleted.
+type pruningStore struct {
+	chain.Store
+	l      log.Logger
+	info   *chain.Info
+	policy RetentionPolicy
+	clock  clock.Clock
+	done   chan bool
+	stop   sync.Once
+	wg     sync.WaitGroup
+
+	mu sync.RWMutex
+	// all the rounds in [1, pruned] have been deleted
+	pruned uint64
+}
+
+// NewPruningStore returns a store that applies the given retention policy to s.
+// It returns s unchanged if the policy doesn't require pruning. The pruner stops
+// when the store is closed.
+func NewPruningStore(l log.Logger, s chain.Store, info *chain.Info, policy RetentionPolicy, cl clock.Clock) chain.Store {
+	if !policy.Enabled() {
+		return s
+	}
+
+	p := newPruningStore(l, s, info, policy, cl)
+	p.wg.Add(1)
+	go p.run()
+	return p
+}
+
+func newPruningStore(l log.Logger, s chain.Store, info *chain.Info, policy RetentionPolicy, cl clock.Clock) *pruningStore {
+	p := &pruningStore{
+		Store:  s,
+		l:      l.Named("pruner"),
+		info:   info,
+		policy: policy,
+		clock:  cl,
+		done:   make(chan bool),
+	}
+	p.pruned = p.lowestStored(context.Background())
+	return p
+}
+
+// lowestStored returns the watermark of a previous run: everything below the
+// first beacon after genesis is considered pruned.
+func (p *pruningStore) lowestStored(ctx context.Context) uint64 {
+	var pruned uint64
+	err := p.Store.Cursor(ctx, func(ctx context.Context, c chain.Cursor) error {
+		b, err := c.First(ctx)
+		if err == nil && b.Round == 0 {
+			b, err = c.Next(ctx)
+		}
+		if err != nil {
+			return err
+		}
+		if b.Round > 1 {
+			pruned = b.Round - 1
+		}
+		return nil
+	})
+	if err != nil {
+		p.l.Debugw("", "pruner", "no beacon stored", "err", err)
+	}
+	return pruned
+}
+
+func (p *pruningStore) isPruned(round uint64) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return round != 0 && round <= p.pruned
+}
+
+// Get returns ErrBeaconPruned for the rounds deleted by the pruner
+func (p *pruningStore) Get(ctx context.Context, round uint64) (*chain.Beacon, error) {
+	if p.isPruned(round) {
+		return &chain.Beacon{}, fmt.Errorf("%w: round %d", chainerrors.ErrBeaconPruned, round)
+	}
+	return p.Store.Get(ctx, round)
+}
+
+// Contributors returns ErrBeaconPruned for the rounds deleted by the pruner
+func (p *pruningStore) Contributors(ctx context.Context, round uint64) (*chain.Contributors, error) {
+	if p.isPruned(round) {
+		return &chain.Contributors{Round: round}, fmt.Errorf("%w: round %d", chainerrors.ErrBeaconPruned, round)
+	}
+	return p.Store.Contributors(ctx, round)
+}
+
+func (p *pruningStore) Close(ctx context.Context) error {
+	p.stop.Do(func() { close(p.done) })
+	p.wg.Wait()
+	return p.Store.Close(ctx)
+}
+
+func (p *pruningStore) run() {
+	defer p.wg.Done()
+
+	ticker := p.clock.NewTicker(pruneInterval)
+	defer ticker.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-p.done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	for {
+		if _, err := p.prune(ctx); err != nil && ctx.Err() == nil {
+			p.l.Errorw("", "pruner", "unable to prune", "err", err)
+		}
+		select {
+		case <-ticker.Chan():
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// cutoff returns the highest round the policy allows to delete. It always
+// keeps the last beacon.
+func (p *pruningStore) cutoff(last uint64) uint64 {
+	var cutoff uint64
+	if p.policy.Rounds > 0 && last > p.policy.Rounds {
+		cutoff = last - p.policy.Rounds
+	}
+	if p.policy.MaxAge > 0 {
+		oldest := p.clock.Now().Add(-p.policy.MaxAge).Unix()
+		// rounds strictly before the one current at that time are too old
+		if r := chain.CurrentRound(oldest, p.info.Period, p.info.GenesisTime); r > 1 && r-1 > cutoff {
+			cutoff = r - 1
+		}
+	}
+	if last > 0 && cutoff >= last {
+		cutoff = last - 1
+	}
+	return cutoff
+}
+
+// prune deletes the rounds falling outside of the retention policy and returns
+// how many rounds it deleted.
+func (p *pruningStore) prune(ctx context.Context) (uint64, error) {
+	last, err := p.Store.Last(ctx)
+	if err != nil {
+		return 0, err
+	}
+	cutoff := p.cutoff(last.Round)
+
+	p.mu.RLock()
+	from := p.pruned + 1
+	p.mu.RUnlock()
+	if cutoff < from {
+		return 0, nil
+	}
+
+	p.l.Debugw("", "pruner", "start", "from", from, "to", cutoff)
+	var deleted uint64
+	for from <= cutoff {
+		to := from + pruneBatchSize - 1
+		if to > cutoff {
+			to = cutoff
+		}
+
+		for r := from; r <= to; r++ {
+			if err := p.Store.Del(ctx, r); err != nil {
+				// the rounds deleted so far are still reported as pruned
+				p.advance(r - 1)
+				return deleted, fmt.Errorf("unable to delete round %d: %w", r, err)
+			}
+			deleted++
+		}
+		// the watermark only moves once the whole batch is gone, so that it
+		// never covers a round still stored
+		p.advance(to)
+
+		if err := ctx.Err(); err != nil {
+			return deleted, err
+		}
+		from = to + 1
+	}
+
+	p.l.Infow("", "pruner", "done", "pruned_up_to", cutoff, "deleted", deleted)
+	return deleted, nil
+}
+
+// advance moves the watermark up to the given round
+func (p *pruningStore) advance(round uint64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if round > p.pruned {
+		p.pruned = round
+	}
+}
@@ -0,0 +1,190 @@
+package beacon
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	clock "github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/chain"
+	"github.com/drand/drand/chain/boltdb"
+	chainerrors "github.com/drand/drand/chain/errors"
+	"github.com/drand/drand/protobuf/common"
+	"github.com/drand/drand/protobuf/drand"
+	"github.com/drand/drand/test"
+)
+
+func newPrunerTestStore(t *testing.T, info *chain.Info, upTo uint64) chain.Store {
+	t.Helper()
+	ctx := context.Background()
+	store, err := boltdb.NewBoltStore(test.Logger(t), t.TempDir(), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { store.Close(ctx) })
+
+	require.NoError(t, store.Put(ctx, chain.GenesisBeacon(info)))
+	for i := uint64(1); i <= upTo; i++ {
+		require.NoError(t, store.Put(ctx, &chain.Beacon{Round: i, Signature: []byte{byte(i)}}))
+	}
+	return store
+}
+
+func TestPruningStoreRounds(t *testing.T) {
+	ctx := context.Background()
+	info := &chain.Info{GenesisSeed: []byte("genesis_seed"), Period: time.Second}
+	store := newPrunerTestStore(t, info, 20)
+
+	// we make sure the pruner deletes across several batches
+	prev := pruneBatchSize
+	pruneBatchSize = 4
+	defer func() { pruneBatchSize = prev }()
+
+	l := test.Logger(t)
+	ps := newPruningStore(l, store, info, RetentionPolicy{Rounds: 5}, clock.NewFakeClock())
+	deleted, err := ps.prune(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(15), deleted)
+
+	_, err = ps.Get(ctx, 15)
+	require.ErrorIs(t, err, chainerrors.ErrBeaconPruned)
+	for i := uint64(16); i <= 20; i++ {
+		b, err := ps.Get(ctx, i)
+		require.NoError(t, err)
+		require.Equal(t, i, b.Round)
+	}
+	_, err = ps.Get(ctx, 21)
+	require.ErrorIs(t, err, chainerrors.ErrNoBeaconStored)
+
+	// genesis is never pruned
+	_, err = ps.Get(ctx, 0)
+	require.NoError(t, err)
+	sLen, err := ps.Len(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 6, sLen)
+
+	// nothing left to prune
+	deleted, err = ps.prune(ctx)
+	require.NoError(t, err)
+	require.Zero(t, deleted)
+
+	// the watermark is recovered from the database
+	ps2 := newPruningStore(l, store, info, RetentionPolicy{Rounds: 5}, clock.NewFakeClock())
+	_, err = ps2.Get(ctx, 15)
+	require.ErrorIs(t, err, chainerrors.ErrBeaconPruned)
+	_, err = ps2.Get(ctx, 16)
+	require.NoError(t, err)
+}
+
+// failingDelStore fails to delete a given round
+type failingDelStore struct {
+	chain.Store
+	round uint64
+}
+
+func (f *failingDelStore) Del(ctx context.Context, round uint64) error {
+	if round == f.round {
+		return errors.New("delete failed")
+	}
+	return f.Store.Del(ctx, round)
+}
+
+func TestPruningStoreDelFailure(t *testing.T) {
+	ctx := context.Background()
+	info := &chain.Info{GenesisSeed: []byte("genesis_seed"), Period: time.Second}
+	store := &failingDelStore{Store: newPrunerTestStore(t, info, 20), round: 7}
+
+	prev := pruneBatchSize
+	pruneBatchSize = 4
+	defer func() { pruneBatchSize = prev }()
+
+	// the watermark stops below the round that couldn't be deleted
+	ps := newPruningStore(test.Logger(t), store, info, RetentionPolicy{Rounds: 5}, clock.NewFakeClock())
+	deleted, err := ps.prune(ctx)
+	require.Error(t, err)
+	require.Equal(t, uint64(6), deleted)
+	_, err = ps.Get(ctx, 6)
+	require.ErrorIs(t, err, chainerrors.ErrBeaconPruned)
+	b, err := ps.Get(ctx, 7)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), b.Round)
+
+	// the next run resumes from there
+	store.round = 0
+	deleted, err = ps.prune(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(9), deleted)
+	_, err = ps.Get(ctx, 15)
+	require.ErrorIs(t, err, chainerrors.ErrBeaconPruned)
+}
+
+func TestPruningStoreMaxAge(t *testing.T) {
+	ctx := context.Background()
+	cl := clock.NewFakeClock()
+	info := &chain.Info{
+		GenesisSeed: []byte("genesis_seed"),
+		Period:      3 * time.Second,
+		GenesisTime: cl.Now().Unix(),
+	}
+	cl.Advance(60 * time.Second)
+	current := chain.CurrentRound(cl.Now().Unix(), info.Period, info.GenesisTime)
+	store := newPrunerTestStore(t, info, current)
+
+	ps := newPruningStore(test.Logger(t), store, info, RetentionPolicy{MaxAge: 30 * time.Second}, cl)
+	_, err := ps.prune(ctx)
+	require.NoError(t, err)
+
+	// the round current 30s ago is the oldest one we keep
+	oldest := chain.CurrentRound(cl.Now().Add(-30*time.Second).Unix(), info.Period, info.GenesisTime)
+	_, err = ps.Get(ctx, oldest-1)
+	require.ErrorIs(t, err, chainerrors.ErrBeaconPruned)
+	_, err = ps.Get(ctx, oldest)
+	require.NoError(t, err)
+
+	// the last beacon is always kept
+	cl.Advance(time.Hour)
+	_, err = ps.prune(ctx)
+	require.NoError(t, err)
+	last, err := ps.Last(ctx)
+	require.NoError(t, err)
+	require.Equal(t, current, last.Round)
+	_, err = ps.Get(ctx, current)
+	require.NoError(t, err)
+}
+
+func TestPruningStoreDisabled(t *testing.T) {
+	info := &chain.Info{GenesisSeed: []byte("genesis_seed"), Period: time.Second}
+	store := newPrunerTestStore(t, info, 1)
+
+	ps := NewPruningStore(test.Logger(t), store, info, RetentionPolicy{}, clock.NewFakeClock())
+	require.Equal(t, store, ps)
+}
+
+type testSyncStream struct {
+	ctx context.Context
+}
+
+func (s *testSyncStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testSyncStream) Send(*drand.BeaconPacket) error {
+	return nil
+}
+
+func TestSyncChainPruned(t *testing.T) {
+	ctx := context.Background()
+	info := &chain.Info{GenesisSeed: []byte("genesis_seed"), Period: time.Second}
+	store := newPrunerTestStore(t, info, 10)
+
+	l := test.Logger(t)
+	ps := newPruningStore(l, store, info, RetentionPolicy{Rounds: 5}, clock.NewFakeClock())
+	_, err := ps.prune(ctx)
+	require.NoError(t, err)
+
+	cbs := NewCallbackStore(ps)
+	req := &drand.SyncRequest{FromRound: 3, Metadata: &common.Metadata{BeaconID: "default"}}
+	err = SyncChain(l, cbs, req, &testSyncStream{ctx: ctx})
+	require.ErrorIs(t, err, chainerrors.ErrBeaconPruned)
+}
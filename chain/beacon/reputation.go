@@ -0,0 +1,259 @@
+package beacon
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	clock "github.com/jonboulle/clockwork"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/drand/drand/common"
+	"github.com/drand/drand/log"
+	"github.com/drand/drand/metrics"
+	"github.com/drand/drand/net"
+	proto "github.com/drand/drand/protobuf/drand"
+)
+
+// Offense is a kind of misbehavior of a peer
+type Offense int
+
+const (
+	// OffenseInvalid is a peer serving data that doesn't verify, such as an
+	// invalid beacon, or a node a DKG disqualified for the deal it signed. The
+	// connections don't authenticate the nodes, and partials or DKG packets
+	// failing their signature check can be forged by anyone, so those aren't
+	// held against the node they claim to come from.
+	OffenseInvalid Offense = iota
+	// OffenseTimeout is a peer that can't be reached or doesn't reply in time
+	OffenseTimeout
+	// OffenseOutOfRound is a peer replying with another round than the one
+	// expected
+	OffenseOutOfRound
+)
+
+func (o Offense) String() string {
+	switch o {
+	case OffenseInvalid:
+		return "invalid"
+	case OffenseTimeout:
+		return "timeout"
+	case OffenseOutOfRound:
+		return "out_of_round"
+	default:
+		return "unknown"
+	}
+}
+
+// IsTimeout returns whether the error of a request means the peer couldn't be
+// reached or didn't reply in time, rather than the peer refusing the request
+func IsTimeout(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// how much each kind of offense lowers the score of a peer
+var offensePenalty = map[Offense]int64{
+	OffenseInvalid:    10,
+	OffenseTimeout:    3,
+	OffenseOutOfRound: 2,
+}
+
+// a peer whose score falls to this threshold is banned for a cooldown, and its
+// score is then reset to half the threshold, so that it's banned again quickly
+// if it keeps misbehaving
+var reputationBanThreshold int64 = -20
+
+// how long a peer stays banned
+var reputationBanCooldown = 10 * time.Minute
+
+// Reputation scores the peers of a beacon by their replies on the sync,
+// partial and DKG paths. Peers start with a score of 0, which offenses lower
+// and valid replies raise back one at a time. Peers with a negative score are
+// tried last when syncing, and peers whose score falls to the ban threshold are
+// not contacted until their cooldown ends. A nil Reputation records nothing.
+type Reputation struct {
+	sync.Mutex
+	l        log.Logger
+	clock    clock.Clock
+	beaconID string
+	peers    map[string]*peerReputation
+}
+
+type peerReputation struct {
+	score       int64
+	bannedUntil time.Time
+	offenses    map[Offense]uint64
+}
+
+// NewReputation returns an empty record of the peers of the given beacon
+func NewReputation(l log.Logger, cl clock.Clock, beaconID string) *Reputation {
+	return &Reputation{
+		l:        l.Named("Reputation"),
+		clock:    cl,
+		beaconID: common.GetCanonicalBeaconID(beaconID),
+		peers:    make(map[string]*peerReputation),
+	}
+}
+
+// Penalize lowers the score of the peer for the offense, banning it if its
+// score falls to the threshold.
+func (r *Reputation) Penalize(addr string, o Offense) {
+	if r == nil {
+		return
+	}
+	r.Lock()
+	defer r.Unlock()
+
+	p := r.peer(addr)
+	p.offenses[o]++
+	p.score -= offensePenalty[o]
+	metrics.PeerOffenses.WithLabelValues(r.beaconID, addr, o.String()).Inc()
+	if p.score <= reputationBanThreshold && !p.banned(r.clock.Now()) {
+		p.bannedUntil = r.clock.Now().Add(reputationBanCooldown)
+		r.l.Warnw("banning peer", "peer", addr, "score", p.score, "until", p.bannedUntil)
+		metrics.PeerBanned.WithLabelValues(r.beaconID, addr).Set(1)
+	}
+	metrics.PeerReputation.WithLabelValues(r.beaconID, addr).Set(float64(p.score))
+}
+
+// Reward raises the score of the peer after a valid reply
+func (r *Reputation) Reward(addr string) {
+	if r == nil {
+		return
+	}
+	r.Lock()
+	defer r.Unlock()
+
+	p, ok := r.peers[addr]
+	if !ok {
+		return
+	}
+	r.expire(addr, p)
+	if p.score >= 0 {
+		return
+	}
+	p.score++
+	metrics.PeerReputation.WithLabelValues(r.beaconID, addr).Set(float64(p.score))
+}
+
+// Banned returns whether the peer is banned
+func (r *Reputation) Banned(addr string) bool {
+	if r == nil {
+		return false
+	}
+	r.Lock()
+	defer r.Unlock()
+
+	p, ok := r.peers[addr]
+	if !ok {
+		return false
+	}
+	r.expire(addr, p)
+	return p.banned(r.clock.Now())
+}
+
+// Rank returns the peers that aren't banned, the ones with the best score
+// first. Peers with the same score keep their order.
+func (r *Reputation) Rank(peers []net.Peer) []net.Peer {
+	if r == nil {
+		return peers
+	}
+	r.Lock()
+	defer r.Unlock()
+
+	now := r.clock.Now()
+	ranked := make([]net.Peer, 0, len(peers))
+	for _, peer := range peers {
+		if p, ok := r.peers[peer.Address()]; ok {
+			r.expire(peer.Address(), p)
+			if p.banned(now) {
+				r.l.Debugw("skipping banned peer", "peer", peer.Address(), "until", p.bannedUntil)
+				continue
+			}
+		}
+		ranked = append(ranked, peer)
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return r.score(ranked[i].Address()) > r.score(ranked[j].Address())
+	})
+	return ranked
+}
+
+// Status returns the reputation of the peers that had an offense, ordered by
+// address
+func (r *Reputation) Status() []*proto.PeerReputationStatus {
+	if r == nil {
+		return nil
+	}
+	r.Lock()
+	defer r.Unlock()
+
+	now := r.clock.Now()
+	status := make([]*proto.PeerReputationStatus, 0, len(r.peers))
+	for addr, p := range r.peers {
+		r.expire(addr, p)
+		s := &proto.PeerReputationStatus{
+			Address:    addr,
+			Score:      p.score,
+			Banned:     p.banned(now),
+			Invalid:    p.offenses[OffenseInvalid],
+			Timeouts:   p.offenses[OffenseTimeout],
+			OutOfRound: p.offenses[OffenseOutOfRound],
+		}
+		if s.Banned {
+			s.BannedUntil = p.bannedUntil.Unix()
+		}
+		status = append(status, s)
+	}
+	sort.Slice(status, func(i, j int) bool { return status[i].Address < status[j].Address })
+	return status
+}
+
+// peer returns the record of a peer, creating it if needed. The caller must
+// hold the lock.
+func (r *Reputation) peer(addr string) *peerReputation {
+	p, ok := r.peers[addr]
+	if !ok {
+		p = &peerReputation{offenses: make(map[Offense]uint64)}
+		r.peers[addr] = p
+	}
+	r.expire(addr, p)
+	return p
+}
+
+// score returns the score of a peer, 0 for peers without any record. The
+// caller must hold the lock.
+func (r *Reputation) score(addr string) int64 {
+	if p, ok := r.peers[addr]; ok {
+		return p.score
+	}
+	return 0
+}
+
+// expire lifts the ban of a peer once its cooldown ended. The caller must hold
+// the lock.
+func (r *Reputation) expire(addr string, p *peerReputation) {
+	if p.bannedUntil.IsZero() || p.banned(r.clock.Now()) {
+		return
+	}
+	p.bannedUntil = time.Time{}
+	p.score = reputationBanThreshold / 2
+	r.l.Infow("ban of peer lifted", "peer", addr, "score", p.score)
+	metrics.PeerBanned.WithLabelValues(r.beaconID, addr).Set(0)
+	metrics.PeerReputation.WithLabelValues(r.beaconID, addr).Set(float64(p.score))
+}
+
+func (p *peerReputation) banned(now time.Time) bool {
+	return now.Before(p.bannedUntil)
+}
@@ -0,0 +1,84 @@
+package beacon
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	clock "github.com/jonboulle/clockwork"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/drand/drand/metrics"
+	"github.com/drand/drand/net"
+	"github.com/drand/drand/test"
+)
+
+func TestReputation(t *testing.T) {
+	cl := clock.NewFakeClock()
+	r := NewReputation(test.Logger(t), cl, "reputation_test")
+	a, b, c := "127.0.0.1:1", "127.0.0.1:2", "127.0.0.1:3"
+	peers := []net.Peer{net.CreatePeer(a, false), net.CreatePeer(b, false), net.CreatePeer(c, false)}
+
+	// a peer that misbehaved is tried last
+	r.Penalize(a, OffenseTimeout)
+	r.Penalize(b, OffenseOutOfRound)
+	require.Equal(t, []net.Peer{peers[2], peers[1], peers[0]}, r.Rank(peers))
+	require.Equal(t, float64(-3), testutil.ToFloat64(metrics.PeerReputation.WithLabelValues("reputation_test", a)))
+
+	// valid replies raise the score back, up to 0
+	r.Reward(a)
+	r.Reward(a)
+	r.Reward(c)
+	require.Equal(t, []net.Peer{peers[2], peers[0], peers[1]}, r.Rank(peers))
+	for i := 0; i < 5; i++ {
+		r.Reward(a)
+	}
+	require.Equal(t, []net.Peer{peers[0], peers[2], peers[1]}, r.Rank(peers))
+
+	// a peer serving invalid data twice is banned for a cooldown
+	r.Penalize(c, OffenseInvalid)
+	require.False(t, r.Banned(c))
+	r.Penalize(c, OffenseInvalid)
+	require.True(t, r.Banned(c))
+	require.Equal(t, []net.Peer{peers[0], peers[1]}, r.Rank(peers))
+	require.Equal(t, float64(1), testutil.ToFloat64(metrics.PeerBanned.WithLabelValues("reputation_test", c)))
+	require.Equal(t, float64(2), testutil.ToFloat64(metrics.PeerOffenses.WithLabelValues("reputation_test", c, "invalid")))
+
+	st := r.Status()
+	require.Len(t, st, 3)
+	require.Equal(t, a, st[0].Address)
+	require.Zero(t, st[0].Score)
+	require.Equal(t, uint64(1), st[0].Timeouts)
+	require.Equal(t, uint64(1), st[1].OutOfRound)
+	require.Equal(t, c, st[2].Address)
+	require.Equal(t, int64(-20), st[2].Score)
+	require.True(t, st[2].Banned)
+	require.Equal(t, cl.Now().Add(reputationBanCooldown).Unix(), st[2].BannedUntil)
+
+	// the ban ends after the cooldown, and a single offense bans the peer again
+	cl.Advance(reputationBanCooldown)
+	require.False(t, r.Banned(c))
+	require.Equal(t, float64(0), testutil.ToFloat64(metrics.PeerBanned.WithLabelValues("reputation_test", c)))
+	require.Equal(t, []net.Peer{peers[0], peers[1], peers[2]}, r.Rank(peers))
+	r.Penalize(c, OffenseInvalid)
+	require.True(t, r.Banned(c))
+
+	// a nil reputation records nothing
+	var none *Reputation
+	none.Penalize(a, OffenseInvalid)
+	require.False(t, none.Banned(a))
+	require.Equal(t, peers, none.Rank(peers))
+	require.Empty(t, none.Status())
+}
+
+func TestIsTimeout(t *testing.T) {
+	require.True(t, IsTimeout(status.Error(codes.Unavailable, "connection refused")))
+	require.True(t, IsTimeout(status.Error(codes.DeadlineExceeded, "too slow")))
+	require.True(t, IsTimeout(context.DeadlineExceeded))
+	require.False(t, IsTimeout(status.Error(codes.Unknown, "invalid round")))
+	require.False(t, IsTimeout(errors.New("invalid partial")))
+	require.False(t, IsTimeout(nil))
+}
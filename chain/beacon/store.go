@@ -58,17 +58,18 @@ func (a *appendStore) Put(ctx context.Context, b *chain.Beacon) error {
 // schemeStore is a store that run different checks depending on what scheme is being used.
 type schemeStore struct {
 	chain.Store
-	sch  scheme.Scheme
-	last *chain.Beacon
+	// chained is set when the scheme links each beacon to the previous one
+	chained bool
+	last    *chain.Beacon
 	sync.Mutex
 }
 
 func NewSchemeStore(s chain.Store, sch scheme.Scheme) chain.Store {
 	last, _ := s.Last(context.Background())
 	return &schemeStore{
-		Store: s,
-		last:  last,
-		sch:   sch,
+		Store:   s,
+		last:    last,
+		chained: key.CryptoFor(sch).Chained,
 	}
 }
 
@@ -79,7 +80,7 @@ func (a *schemeStore) Put(ctx context.Context, b *chain.Beacon) error {
 	// If the scheme is unchained, previous signature is set to nil. In that case,
 	// relationship between signature in the previous beacon and previous signature
 	// on the actual beacon is not necessary. Otherwise, it will be checked.
-	if a.sch.DecouplePrevSig {
+	if !a.chained {
 		b.PreviousSig = nil
 	} else if !bytes.Equal(a.last.Signature, b.PreviousSig) {
 		if pb, err := a.Get(ctx, b.Round-1); err != nil || !bytes.Equal(pb.Signature, b.PreviousSig) {
@@ -0,0 +1,120 @@
+package beacon
+
+import (
+	"context"
+	gonet "net"
+	"sync"
+	"time"
+
+	clock "github.com/jonboulle/clockwork"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SyncLimits bounds the resources a node spends serving sync requests. A zero
+// value disables the corresponding limit.
+type SyncLimits struct {
+	// MaxStreams is the number of sync streams served at the same time
+	MaxStreams int
+	// MaxStreamsPerPeer is the number of sync streams served at the same time
+	// to a single remote address
+	MaxStreamsPerPeer int
+	// BytesPerSecond is the bandwidth shared by all the sync streams
+	BytesPerSecond int64
+}
+
+// SyncLimiter admits the sync streams within the limits and throttles the
+// beacons they send. Streams over the limits are rejected with a
+// ResourceExhausted status, which syncing nodes retry later.
+type SyncLimiter struct {
+	sync.Mutex
+	clock   clock.Clock
+	limits  SyncLimits
+	streams int
+	perPeer map[string]int
+
+	// token bucket of the bandwidth budget, holding at most one second of it
+	tokens float64
+	last   time.Time
+}
+
+// NewSyncLimiter returns a limiter enforcing the given limits, refilling the
+// bandwidth budget on the given clock
+func NewSyncLimiter(c clock.Clock, limits SyncLimits) *SyncLimiter {
+	return &SyncLimiter{
+		clock:   c,
+		limits:  limits,
+		perPeer: make(map[string]int),
+		tokens:  float64(limits.BytesPerSecond),
+		last:    c.Now(),
+	}
+}
+
+// Acquire admits a new stream from the given remote address. The returned
+// function releases the stream once it ends.
+func (l *SyncLimiter) Acquire(addr string) (func(), error) {
+	if host, _, err := gonet.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+
+	l.Lock()
+	defer l.Unlock()
+	if l.limits.MaxStreams > 0 && l.streams >= l.limits.MaxStreams {
+		return nil, status.Errorf(codes.ResourceExhausted, "sync: already serving %d streams", l.streams)
+	}
+	if l.limits.MaxStreamsPerPeer > 0 && l.perPeer[addr] >= l.limits.MaxStreamsPerPeer {
+		return nil, status.Errorf(codes.ResourceExhausted, "sync: already serving %d streams to %s", l.perPeer[addr], addr)
+	}
+	l.streams++
+	l.perPeer[addr]++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.Lock()
+			defer l.Unlock()
+			l.streams--
+			if l.perPeer[addr]--; l.perPeer[addr] == 0 {
+				delete(l.perPeer, addr)
+			}
+		})
+	}, nil
+}
+
+// Wait blocks until n more bytes can be sent within the bandwidth budget
+func (l *SyncLimiter) Wait(ctx context.Context, n int) error {
+	if l.limits.BytesPerSecond <= 0 {
+		return nil
+	}
+	rate := float64(l.limits.BytesPerSecond)
+
+	l.Lock()
+	now := l.clock.Now()
+	l.tokens += now.Sub(l.last).Seconds() * rate
+	if l.tokens > rate {
+		l.tokens = rate
+	}
+	l.last = now
+	// the bytes are reserved right away, the next senders wait for them too
+	l.tokens -= float64(n)
+	delay := time.Duration(-l.tokens / rate * float64(time.Second))
+	l.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+	t := l.clock.NewTimer(delay)
+	defer t.Stop()
+	select {
+	case <-t.Chan():
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// IsBusy returns whether the error means the peer rejected the sync request
+// because of its limits, and should be retried later
+func IsBusy(err error) bool {
+	return status.Code(err) == codes.ResourceExhausted
+}
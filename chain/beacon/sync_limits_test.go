@@ -0,0 +1,87 @@
+package beacon
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	clock "github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSyncLimiterStreams(t *testing.T) {
+	l := NewSyncLimiter(clock.NewFakeClock(), SyncLimits{MaxStreams: 3, MaxStreamsPerPeer: 2})
+
+	// the limit per peer applies to its host, whatever its port
+	r1, err := l.Acquire("10.0.0.1:1234")
+	require.NoError(t, err)
+	r2, err := l.Acquire("10.0.0.1:1235")
+	require.NoError(t, err)
+	_, err = l.Acquire("10.0.0.1:1236")
+	require.True(t, IsBusy(err))
+
+	r3, err := l.Acquire("10.0.0.2:1234")
+	require.NoError(t, err)
+	_, err = l.Acquire("10.0.0.3:1234")
+	require.True(t, IsBusy(err))
+
+	// releasing twice only frees one stream
+	r1()
+	r1()
+	r4, err := l.Acquire("10.0.0.1:1237")
+	require.NoError(t, err)
+	_, err = l.Acquire("10.0.0.3:1234")
+	require.True(t, IsBusy(err))
+
+	r2()
+	r3()
+	r4()
+	_, err = l.Acquire("10.0.0.3:1234")
+	require.NoError(t, err)
+}
+
+func TestSyncLimiterBandwidth(t *testing.T) {
+	ctx := context.Background()
+	c := clock.NewFakeClock()
+	l := NewSyncLimiter(c, SyncLimits{BytesPerSecond: 1000})
+
+	// a second of budget is available right away, the rest is throttled
+	require.NoError(t, l.Wait(ctx, 1000))
+	done := make(chan error, 1)
+	go func() { done <- l.Wait(ctx, 200) }()
+	c.BlockUntil(1)
+	c.Advance(199 * time.Millisecond)
+	select {
+	case <-done:
+		require.Fail(t, "bytes sent over the budget")
+	case <-time.After(50 * time.Millisecond):
+	}
+	c.Advance(time.Millisecond)
+	require.NoError(t, <-done)
+
+	// the budget refills with the time, up to one second of it
+	c.Advance(10 * time.Second)
+	require.NoError(t, l.Wait(ctx, 1000))
+	go func() { done <- l.Wait(ctx, 1) }()
+	c.BlockUntil(1)
+	c.Advance(time.Millisecond)
+	require.NoError(t, <-done)
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	require.ErrorIs(t, l.Wait(canceled, 1000), context.Canceled)
+
+	// without a budget nothing waits
+	unlimited := NewSyncLimiter(c, SyncLimits{})
+	require.NoError(t, unlimited.Wait(ctx, 1<<30))
+}
+
+func TestIsBusy(t *testing.T) {
+	require.True(t, IsBusy(status.Error(codes.ResourceExhausted, "busy")))
+	require.False(t, IsBusy(status.Error(codes.Unavailable, "down")))
+	require.False(t, IsBusy(errors.New("busy")))
+	require.False(t, IsBusy(nil))
+}
@@ -1,6 +1,7 @@
 package beacon
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -46,6 +47,18 @@ type SyncManager struct {
 	mu      sync.Mutex
 	// we need to know our current daemon address
 	nodeAddr string
+	// peers serving invalid beacons are tried last, or not at all
+	reputation *Reputation
+	// peers rejecting our requests because of their sync limits, by address
+	backoffs map[string]*syncBackoff
+	// where an interrupted check of the chain saves how far it went
+	checkpointFile string
+}
+
+// syncBackoff is the delay before syncing again with a busy peer
+type syncBackoff struct {
+	until time.Time
+	delay time.Duration
 }
 
 // sync manager will renew sync if nothing happens for factor*period time
@@ -54,6 +67,24 @@ var syncExpiryFactor = 2
 // how many sync requests do we allow buffering
 var syncQueueRequest = 3
 
+// gaps larger than this many rounds are fetched in chunks of that size from
+// several peers at once
+var syncChunkSize uint64 = 5000
+
+// how many peers we fetch chunks from at the same time
+var syncParallelPeers = 4
+
+// a peer that doesn't serve a whole chunk within this delay is dropped
+var syncChunkTimeout = 2 * time.Minute
+
+// a peer busy serving other nodes is tried again after this delay, doubled
+// each time it is still busy, up to syncBackoffMax
+var syncBackoffMin = time.Second
+var syncBackoffMax = time.Minute
+
+// how many more times a sync tries the peers that were busy
+var syncBusyRetries = 3
+
 // ErrFailedAll means all nodes failed to provide the requested beacons
 var ErrFailedAll = errors.New("sync failed: tried all nodes")
 
@@ -65,25 +96,33 @@ type SyncConfig struct {
 	BoltdbStore chain.Store
 	Info        *chain.Info
 	NodeAddr    string
+	Reputation  *Reputation
+	// CheckpointFile is where a check of the chain saves its progress, so
+	// that the next check resumes from there if it's interrupted. An empty
+	// path disables the checkpoints.
+	CheckpointFile string
 }
 
 // NewSyncManager returns a sync manager that will use the given store to store
 // newly synced beacon.
 func NewSyncManager(c *SyncConfig) *SyncManager {
 	return &SyncManager{
-		log:           c.Log.Named("SyncManager"),
-		clock:         c.Clock,
-		store:         c.Store,
-		insecureStore: c.BoltdbStore,
-		info:          c.Info,
-		client:        c.Client,
-		period:        c.Info.Period,
-		verifier:      c.Info.Verifier(),
-		nodeAddr:      c.NodeAddr,
-		factor:        syncExpiryFactor,
-		newReq:        make(chan requestInfo, syncQueueRequest),
-		newSync:       make(chan *chain.Beacon, 1),
-		done:          make(chan bool, 1),
+		log:            c.Log.Named("SyncManager"),
+		clock:          c.Clock,
+		store:          c.Store,
+		insecureStore:  c.BoltdbStore,
+		info:           c.Info,
+		client:         c.Client,
+		period:         c.Info.Period,
+		verifier:       c.Info.Verifier(),
+		nodeAddr:       c.NodeAddr,
+		reputation:     c.Reputation,
+		backoffs:       make(map[string]*syncBackoff),
+		checkpointFile: c.CheckpointFile,
+		factor:         syncExpiryFactor,
+		newReq:         make(chan requestInfo, syncQueueRequest),
+		newSync:        make(chan *chain.Beacon, 1),
+		done:           make(chan bool, 1),
 	}
 }
 
@@ -161,73 +200,6 @@ func (s *SyncManager) Run() {
 	}
 }
 
-func (s *SyncManager) CheckPastBeacons(ctx context.Context, upTo uint64, cb func(r, u uint64)) ([]uint64, error) {
-	logger := s.log.Named("pastBeaconCheck")
-	logger.Debugw("Starting to check past beacons", "upTo", upTo)
-
-	last, err := s.store.Last(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("unable to fetch and check last beacon in store: %w", err)
-	}
-
-	if last.Round < upTo {
-		logger.Errorw("No beacon stored above", "last round", last.Round, "requested round", upTo)
-		logger.Infow("Checking beacons only up to the last stored", "round", last.Round)
-		upTo = last.Round
-	}
-
-	var faultyBeacons []uint64
-	// notice that we do not validate the genesis round 0
-	storeLen, err := s.store.Len(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("error while retrieving store size: %w", err)
-	}
-	for i := uint64(1); i < uint64(storeLen); i++ {
-		select {
-		case <-ctx.Done():
-			logger.Debugw("Context done, returning")
-			return nil, ctx.Err()
-		default:
-		}
-
-		// we call our callback with the round to send the progress, N.B. we need to do it before returning.
-		// Batching/rate-limiting is handled on the callback side
-		if cb != nil {
-			cb(i, upTo)
-		}
-
-		b, err := s.store.Get(ctx, i)
-		if err != nil {
-			logger.Errorw("unable to fetch beacon in store", "round", i, "err", err)
-			faultyBeacons = append(faultyBeacons, i)
-			if i >= upTo {
-				break
-			}
-			continue
-		}
-		// verify the signature validity
-		if err = s.verifier.VerifyBeacon(*b, s.info.PublicKey); err != nil {
-			logger.Errorw("invalid_beacon", "round", b.Round, "err", err)
-			faultyBeacons = append(faultyBeacons, b.Round)
-		} else if i%commonutils.LogsToSkip == 0 { // we do some rate limiting on the logging
-			logger.Debugw("valid_beacon", "round", b.Round)
-		}
-
-		if i >= upTo {
-			break
-		}
-	}
-
-	logger.Debugw("Finished checking past beacons", "faulty_beacons", len(faultyBeacons))
-
-	if len(faultyBeacons) > 0 {
-		logger.Warnw("Found invalid beacons in store", "amount", len(faultyBeacons))
-		return faultyBeacons, nil
-	}
-
-	return nil, nil
-}
-
 func (s *SyncManager) CorrectPastBeacons(ctx context.Context, faultyBeacons []uint64, peers []net.Peer, cb func(r, u uint64)) error {
 	target := uint64(len(faultyBeacons))
 	if target == 0 {
@@ -293,29 +265,333 @@ func (s *SyncManager) ReSync(ctx context.Context, from, to uint64, nodes []net.P
 func (s *SyncManager) Sync(ctx context.Context, request requestInfo) error {
 	s.log.Debugw("starting new sync", "sync_manager", "start sync", "up_to", request.upTo, "nodes", peersToString(request.nodes))
 	// shuffle through the nodes
+	peers := make([]net.Peer, 0, len(request.nodes))
 	for _, n := range rand.Perm(len(request.nodes)) {
 		if request.nodes[n].Address() == s.nodeAddr {
 			// we ignore our own node
 			s.log.Debugw("skipping sync with our own node", "sync_manager", "sync")
 			continue
 		}
-		select {
-		// let us cancel early in case the context is canceled
-		case <-ctx.Done():
-			s.log.Debugw("sync canceled early", "source", "ctx", "err?", ctx.Err())
-			return fmt.Errorf("ctx done: sync canceled")
-		default:
-			node := request.nodes[n]
-			if s.tryNode(ctx, request.from, request.upTo, node) {
-				// we stop as soon as we've done a successful sync with a node
-				return nil
+		peers = append(peers, request.nodes[n])
+	}
+	// the peers that misbehaved come last, the banned ones aren't tried
+	peers = s.reputation.Rank(peers)
+
+	// a large gap is first fetched from several peers at once, the rounds
+	// left, if any, are then synced from a single peer
+	if last := s.parallelSync(ctx, request, peers); last > 0 {
+		if request.upTo > 0 && last >= request.upTo {
+			return nil
+		}
+		if request.from > 0 {
+			request.from = last + 1
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		var busy []net.Peer
+		for _, node := range peers {
+			// the busy peers are tried again once the others failed, and after
+			// their backoff
+			wait := s.backoff(node)
+			if wait > 0 && attempt == 0 {
+				busy = append(busy, node)
+				continue
+			}
+			select {
+			// let us cancel early in case the context is canceled
+			case <-ctx.Done():
+				s.log.Debugw("sync canceled early", "source", "ctx", "err?", ctx.Err())
+				return fmt.Errorf("ctx done: sync canceled")
+			case <-s.clock.After(wait):
+				if s.tryNode(ctx, request.from, request.upTo, node) {
+					// we stop as soon as we've done a successful sync with a node
+					return nil
+				}
+				if s.backoff(node) > 0 {
+					busy = append(busy, node)
+				}
 			}
 		}
+		if len(busy) == 0 || attempt == syncBusyRetries {
+			break
+		}
+		s.log.Debugw("retrying busy nodes", "sync_manager", "backoff", "nodes", peersToString(busy), "attempt", attempt+1)
+		peers = busy
 	}
 	s.log.Debugw("Tried all nodes without success", "sync_manager", "failed sync")
 	return ErrFailedAll
 }
 
+// busy backs off from the peer, which rejected our request because of its
+// sync limits
+func (s *SyncManager) busy(peer net.Peer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, ok := s.backoffs[peer.Address()]
+	if !ok {
+		b = &syncBackoff{delay: syncBackoffMin}
+		s.backoffs[peer.Address()] = b
+	} else {
+		b.delay *= 2
+		if b.delay > syncBackoffMax {
+			b.delay = syncBackoffMax
+		}
+	}
+	b.until = s.clock.Now().Add(b.delay)
+}
+
+// accepted clears the backoff of the peer once it accepts a request
+func (s *SyncManager) accepted(peer net.Peer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.backoffs, peer.Address())
+}
+
+// backoff returns how long to wait before syncing with the peer again
+func (s *SyncManager) backoff(peer net.Peer) time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, ok := s.backoffs[peer.Address()]
+	if !ok {
+		return 0
+	}
+	if wait := b.until.Sub(s.clock.Now()); wait > 0 {
+		return wait
+	}
+	return 0
+}
+
+// syncChunk is a range of rounds fetched from a single peer
+type syncChunk struct {
+	idx  int
+	from uint64
+	to   uint64
+}
+
+// chunkResult holds the beacons of a chunk, or the error of the peer that
+// failed to serve it
+type chunkResult struct {
+	chunk   syncChunk
+	peer    net.Peer
+	beacons []*chain.Beacon
+	err     error
+}
+
+// parallelSync fetches the rounds of the request up to its target in chunks
+// downloaded from several peers at once. The chunks are verified as they come
+// and appended to the store in order. It returns the last round appended, 0 if
+// the gap isn't worth splitting or if nothing could be appended.
+//
+//nolint:gocyclo,funlen
+func (s *SyncManager) parallelSync(global context.Context, request requestInfo, peers []net.Peer) uint64 {
+	logger := s.log.Named("parallelSync")
+
+	// if from > 0 then we're doing a ReSync, not a plain Sync.
+	isResync := request.from > 0
+	put := s.store.Put
+	if isResync {
+		put = s.insecureStore.Put
+	}
+
+	last, err := s.store.Last(global)
+	if err != nil {
+		logger.Errorw("unable to fetch from store", "sync_manager", "store.Last", "err", err)
+		return 0
+	}
+	from := request.from
+	if from == 0 {
+		from = last.Round + 1
+	}
+	target := request.upTo
+	if target == 0 {
+		target = chain.CurrentRound(s.clock.Now().Unix(), s.info.Period, s.info.GenesisTime)
+	}
+	if len(peers) < 2 || target < from || target-from < syncChunkSize {
+		return 0
+	}
+
+	// with a chained scheme, each chunk must link to the signature the previous
+	// one ends with, the first one to our last beacon
+	var prevSig []byte
+	if s.verifier.IsPrevSigMeaningful() && !isResync {
+		prevSig = last.Signature
+	}
+
+	var chunks []syncChunk
+	for f := from; f <= target; f += syncChunkSize {
+		to := f + syncChunkSize - 1
+		if to > target {
+			to = target
+		}
+		chunks = append(chunks, syncChunk{idx: len(chunks), from: f, to: to})
+	}
+
+	workers := len(peers)
+	if workers > syncParallelPeers {
+		workers = syncParallelPeers
+	}
+	spare := peers[workers:]
+	// we don't fetch more than window chunks ahead of the one we wait for, so
+	// that's all we hold in memory and all the queue ever holds
+	window := 2 * workers
+
+	ctx, cancel := context.WithCancel(global)
+	defer cancel()
+	todo := make(chan syncChunk, window)
+	results := make(chan chunkResult)
+	for _, peer := range peers[:workers] {
+		go s.chunkWorker(ctx, peer, todo, results)
+	}
+	alive := workers
+
+	logger.Infow("starting parallel sync", "from_round", from, "up_to", target, "chunks", len(chunks), "peers", workers)
+
+	var appended uint64
+	pending := make(map[int][]*chain.Beacon)
+	sent, next := 0, 0
+	for next < len(chunks) {
+		for ; sent < len(chunks) && sent < next+window; sent++ {
+			todo <- chunks[sent]
+		}
+
+		select {
+		case res := <-results:
+			if res.err != nil {
+				logger.Debugw("chunk failed", "with_peer", res.peer.Address(), "from_round", res.chunk.from, "up_to", res.chunk.to, "err", res.err)
+				// the worker of that peer exits, another peer takes over if we have one
+				alive--
+				if len(spare) > 0 {
+					go s.chunkWorker(ctx, spare[0], todo, results)
+					spare = spare[1:]
+					alive++
+				}
+				if alive == 0 {
+					logger.Warnw("all peers failed", "last_round", appended)
+					return appended
+				}
+				todo <- res.chunk
+				continue
+			}
+
+			// the chunks are appended in order, as soon as the next one is there
+			pending[res.chunk.idx] = res.beacons
+			for beacons, ok := pending[next]; ok; beacons, ok = pending[next] {
+				delete(pending, next)
+				if prevSig != nil && !bytes.Equal(prevSig, beacons[0].PreviousSig) {
+					logger.Warnw("chunk doesn't link to the previous one", "from_round", chunks[next].from)
+					return appended
+				}
+				for _, b := range beacons {
+					if err := put(ctx, b); err != nil {
+						logger.Errorw("unable to save", "round", b.Round, "err", err)
+						return appended
+					}
+				}
+				lastBeacon := beacons[len(beacons)-1]
+				if s.verifier.IsPrevSigMeaningful() {
+					prevSig = lastBeacon.Signature
+				}
+				appended = lastBeacon.Round
+				next++
+				logger.Debugw("chunk synced", "up_to", appended, "chunks_left", len(chunks)-next)
+			}
+		case <-global.Done():
+			logger.Debugw("sync canceled", "source", "global", "err?", global.Err())
+			return appended
+		}
+	}
+	return appended
+}
+
+// chunkWorker fetches chunks from the peer until it fails to serve one
+func (s *SyncManager) chunkWorker(ctx context.Context, peer net.Peer, todo <-chan syncChunk, results chan<- chunkResult) {
+	for {
+		select {
+		case c := <-todo:
+			beacons, err := s.fetchChunk(ctx, peer, c)
+			if err == nil {
+				s.reputation.Reward(peer.Address())
+			}
+			select {
+			case results <- chunkResult{chunk: c, peer: peer, beacons: beacons, err: err}:
+			case <-ctx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// fetchChunk downloads the rounds of the chunk from the peer and verifies them
+// independently of the rest of the chain: unchained beacons are verified one by
+// one, chained beacons must also link to each other within the chunk.
+func (s *SyncManager) fetchChunk(global context.Context, peer net.Peer, c syncChunk) ([]*chain.Beacon, error) {
+	// the peer streams past the end of the chunk until we cancel the request
+	ctx, cancel := context.WithTimeout(global, syncChunkTimeout)
+	defer cancel()
+
+	req := &proto.SyncRequest{
+		FromRound: c.from,
+		Metadata:  &common.Metadata{BeaconID: s.info.ID},
+	}
+	beaconCh, err := s.client.SyncChain(ctx, peer, req)
+	if IsBusy(err) {
+		s.busy(peer)
+		return nil, err
+	}
+	if err != nil {
+		s.reputation.Penalize(peer.Address(), OffenseTimeout)
+		return nil, err
+	}
+	s.accepted(peer)
+
+	beacons := make([]*chain.Beacon, 0, c.to-c.from+1)
+	for {
+		select {
+		case beaconPacket, ok := <-beaconCh:
+			expected := c.from + uint64(len(beacons))
+			if !ok {
+				return nil, fmt.Errorf("SyncChain channel closed before round %d", expected)
+			}
+			if metadata := beaconPacket.GetMetadata(); metadata != nil && metadata.BeaconID != s.info.ID {
+				s.reputation.Penalize(peer.Address(), OffenseInvalid)
+				return nil, fmt.Errorf("wrong beaconID: expected %s, got %s", s.info.ID, metadata.BeaconID)
+			}
+
+			beacon := protoToBeacon(beaconPacket)
+			if beacon.Round != expected {
+				s.reputation.Penalize(peer.Address(), OffenseOutOfRound)
+				return nil, fmt.Errorf("unexpected round: expected %d, got %d", expected, beacon.Round)
+			}
+			if err := s.verifier.VerifyBeacon(*beacon, s.info.PublicKey); err != nil {
+				s.reputation.Penalize(peer.Address(), OffenseInvalid)
+				return nil, fmt.Errorf("invalid beacon %d: %w", beacon.Round, err)
+			}
+			if n := len(beacons); n > 0 && s.verifier.IsPrevSigMeaningful() &&
+				!bytes.Equal(beacons[n-1].Signature, beacon.PreviousSig) {
+				s.reputation.Penalize(peer.Address(), OffenseInvalid)
+				return nil, fmt.Errorf("beacon %d doesn't link to the previous one", beacon.Round)
+			}
+
+			beacons = append(beacons, beacon)
+			if beacon.Round == c.to {
+				return beacons, nil
+			}
+		case <-ctx.Done():
+			// the sync itself may have been canceled
+			if global.Err() == nil {
+				s.reputation.Penalize(peer.Address(), OffenseTimeout)
+			}
+			return nil, ctx.Err()
+		}
+	}
+}
+
 // tryNode tries to sync up with the given peer up to the given round, starting
 // from the last beacon in the store. It returns true if the objective was
 // reached (store.Last() returns upTo) and false otherwise.
@@ -351,10 +627,17 @@ func (s *SyncManager) tryNode(global context.Context, from, upTo uint64, peer ne
 	}
 
 	beaconCh, err := s.client.SyncChain(cnode, peer, req)
+	if IsBusy(err) {
+		logger.Debugw("peer busy, backing off", "with_peer", peer.Address(), "err", err)
+		s.busy(peer)
+		return false
+	}
 	if err != nil {
 		logger.Errorw("unable_to_sync", "with_peer", peer.Address(), "err", err)
+		s.reputation.Penalize(peer.Address(), OffenseTimeout)
 		return false
 	}
+	s.accepted(peer)
 
 	// for effective rate limiting but not when we are caught up and following a chain live
 	target := chain.CurrentRound(s.clock.Now().Unix(), s.info.Period, s.info.GenesisTime)
@@ -377,6 +660,7 @@ func (s *SyncManager) tryNode(global context.Context, from, upTo uint64, peer ne
 			metadata := beaconPacket.GetMetadata()
 			if metadata != nil && metadata.BeaconID != s.info.ID {
 				logger.Errorw("wrong beaconID", "expected", s.info.ID, "got", metadata.BeaconID)
+				s.reputation.Penalize(peer.Address(), OffenseInvalid)
 				return false
 			}
 
@@ -394,6 +678,7 @@ func (s *SyncManager) tryNode(global context.Context, from, upTo uint64, peer ne
 			// verify the signature validity
 			if err := s.verifier.VerifyBeacon(*beacon, s.info.PublicKey); err != nil {
 				logger.Debugw("Invalid_beacon", "from_peer", peer.Address(), "round", beacon.Round, "err", err, "beacon", fmt.Sprintf("%+v", beacon))
+				s.reputation.Penalize(peer.Address(), OffenseInvalid)
 				return false
 			}
 
@@ -418,6 +703,7 @@ func (s *SyncManager) tryNode(global context.Context, from, upTo uint64, peer ne
 			last = beacon
 			if last.Round == upTo {
 				logger.Debugw("sync_manager finished syncing up to", "round", upTo)
+				s.reputation.Reward(peer.Address())
 				return true
 			}
 			// else, we keep waiting for the next beacons
@@ -469,6 +755,13 @@ func SyncChain(l log.Logger, store CallbackStore, req SyncRequest, stream SyncSt
 		return fmt.Errorf("%w %d < %d", chainerrors.ErrNoBeaconStored, last.Round, fromRound)
 	}
 
+	// a node with a retention policy can't serve the rounds it pruned
+	if fromRound != 0 {
+		if _, err := store.Get(ctx, fromRound); errors.Is(err, chainerrors.ErrBeaconPruned) {
+			return err
+		}
+	}
+
 	send := func(b *chain.Beacon) error {
 		packet := beaconToProto(b)
 		packet.Metadata = &common.Metadata{BeaconID: beaconID}
@@ -0,0 +1,249 @@
+package beacon
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	clock "github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/drand/drand/chain"
+	"github.com/drand/drand/chain/memdb"
+	"github.com/drand/drand/net"
+	"github.com/drand/drand/protobuf/drand"
+	"github.com/drand/drand/test"
+)
+
+// peersSyncClient serves sync requests from a store holding the whole chain,
+// some peers being slow or serving invalid beacons
+type peersSyncClient struct {
+	net.ProtocolClient
+	src  chain.Store
+	slow map[string]time.Duration
+	bad  map[string]bool
+	// busy peers reject that many requests first
+	busy map[string]int
+
+	mu       sync.Mutex
+	requests map[string]int
+}
+
+func (c *peersSyncClient) SyncChain(ctx context.Context, p net.Peer, in *drand.SyncRequest,
+	_ ...net.CallOption) (chan *drand.BeaconPacket, error) {
+	c.mu.Lock()
+	c.requests[p.Address()]++
+	if c.busy[p.Address()] > 0 {
+		c.busy[p.Address()]--
+		c.mu.Unlock()
+		return nil, status.Error(codes.ResourceExhausted, "busy")
+	}
+	c.mu.Unlock()
+
+	ch := make(chan *drand.BeaconPacket)
+	go func() {
+		defer close(ch)
+		for r := in.GetFromRound(); ; r++ {
+			b, err := c.src.Get(ctx, r)
+			if err != nil {
+				return
+			}
+			packet := beaconToProto(b)
+			if c.bad[p.Address()] {
+				packet.Signature = []byte("not a signature")
+			}
+			time.Sleep(c.slow[p.Address()])
+			select {
+			case ch <- packet:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
+func TestSyncManagerParallel(t *testing.T) {
+	ctx := context.Background()
+	l := test.Logger(t)
+	n := uint64(60)
+	info, src := newAuditorTestChain(t, n)
+
+	prevSize, prevPeers := syncChunkSize, syncParallelPeers
+	syncChunkSize, syncParallelPeers = 7, 4
+	defer func() { syncChunkSize, syncParallelPeers = prevSize, prevPeers }()
+
+	// the store refuses beacons out of order
+	mem := memdb.NewStore(l, int(n)+1)
+	genesis, err := src.Get(ctx, 0)
+	require.NoError(t, err)
+	require.NoError(t, mem.Put(ctx, genesis))
+	store := NewSchemeStore(newAppendStore(mem), info.Scheme)
+
+	peers := []net.Peer{
+		net.CreatePeer("127.0.0.1:1", false),
+		net.CreatePeer("127.0.0.1:2", false),
+		net.CreatePeer("127.0.0.1:3", false),
+		net.CreatePeer("127.0.0.1:4", false),
+		net.CreatePeer("127.0.0.1:5", false),
+	}
+	client := &peersSyncClient{
+		src: src,
+		// chunks complete out of order
+		slow:     map[string]time.Duration{peers[1].Address(): 5 * time.Millisecond},
+		bad:      map[string]bool{peers[2].Address(): true},
+		requests: make(map[string]int),
+	}
+	syncm := NewSyncManager(&SyncConfig{
+		Log:         l,
+		Client:      client,
+		Clock:       clock.NewFakeClock(),
+		Store:       store,
+		BoltdbStore: mem,
+		Info:        info,
+		NodeAddr:    peers[0].Address(),
+		Reputation:  NewReputation(l, clock.NewFakeClock(), info.ID),
+	})
+
+	require.NoError(t, syncm.Sync(ctx, requestInfo{nodes: peers, upTo: n}))
+	for i := uint64(1); i <= n; i++ {
+		expected, err := src.Get(ctx, i)
+		require.NoError(t, err)
+		b, err := mem.Get(ctx, i)
+		require.NoError(t, err)
+		require.True(t, expected.Equal(b), "round %d", i)
+	}
+
+	// we never sync with ourselves, and the chunks were spread over the peers
+	require.Zero(t, client.requests[peers[0].Address()])
+	served := 0
+	for _, p := range peers[1:] {
+		if client.requests[p.Address()] > 0 {
+			served++
+		}
+	}
+	require.Greater(t, served, 1)
+
+	// the peer serving invalid beacons was penalized, and is tried last
+	rep := syncm.reputation.Status()
+	require.Len(t, rep, 1)
+	require.Equal(t, peers[2].Address(), rep[0].Address)
+	require.Equal(t, uint64(1), rep[0].Invalid)
+	ranked := syncm.reputation.Rank(peers)
+	require.Equal(t, peers[2], ranked[len(ranked)-1])
+
+	// a resync also runs in parallel and replaces the beacons
+	require.NoError(t, mem.Put(ctx, &chain.Beacon{Round: 30, PreviousSig: genesis.Signature, Signature: []byte("not a signature")}))
+	require.NoError(t, syncm.ReSync(ctx, 20, 50, peers))
+	for i := uint64(20); i <= 50; i++ {
+		expected, err := src.Get(ctx, i)
+		require.NoError(t, err)
+		b, err := mem.Get(ctx, i)
+		require.NoError(t, err)
+		require.True(t, expected.Equal(b), "round %d", i)
+	}
+}
+
+func TestSyncManagerParallelAllPeersFail(t *testing.T) {
+	ctx := context.Background()
+	l := test.Logger(t)
+	n := uint64(30)
+	info, src := newAuditorTestChain(t, n)
+
+	prevSize := syncChunkSize
+	syncChunkSize = 5
+	defer func() { syncChunkSize = prevSize }()
+
+	mem := memdb.NewStore(l, int(n)+1)
+	genesis, err := src.Get(ctx, 0)
+	require.NoError(t, err)
+	require.NoError(t, mem.Put(ctx, genesis))
+
+	peers := []net.Peer{
+		net.CreatePeer("127.0.0.1:2", false),
+		net.CreatePeer("127.0.0.1:3", false),
+	}
+	client := &peersSyncClient{
+		src:      src,
+		bad:      map[string]bool{peers[0].Address(): true, peers[1].Address(): true},
+		requests: make(map[string]int),
+	}
+	syncm := NewSyncManager(&SyncConfig{
+		Log:         l,
+		Client:      client,
+		Clock:       clock.NewFakeClock(),
+		Store:       mem,
+		BoltdbStore: mem,
+		Info:        info,
+		NodeAddr:    "127.0.0.1:1",
+	})
+
+	require.ErrorIs(t, syncm.Sync(ctx, requestInfo{nodes: peers, upTo: n}), ErrFailedAll)
+	last, err := mem.Last(ctx)
+	require.NoError(t, err)
+	require.Zero(t, last.Round)
+}
+
+func TestSyncManagerBusyPeer(t *testing.T) {
+	ctx := context.Background()
+	l := test.Logger(t)
+	n := uint64(10)
+	info, src := newAuditorTestChain(t, n)
+
+	mem := memdb.NewStore(l, int(n)+1)
+	genesis, err := src.Get(ctx, 0)
+	require.NoError(t, err)
+	require.NoError(t, mem.Put(ctx, genesis))
+
+	peer := net.CreatePeer("127.0.0.1:2", false)
+	client := &peersSyncClient{
+		src:      src,
+		busy:     map[string]int{peer.Address(): 2},
+		requests: make(map[string]int),
+	}
+	rep := NewReputation(l, clock.NewFakeClock(), info.ID)
+	c := clock.NewFakeClock()
+	syncm := NewSyncManager(&SyncConfig{
+		Log:         l,
+		Client:      client,
+		Clock:       c,
+		Store:       mem,
+		BoltdbStore: mem,
+		Info:        info,
+		NodeAddr:    "127.0.0.1:1",
+		Reputation:  rep,
+	})
+
+	// syncs while moving the clock forward each time the sync backs off
+	syncBackingOff := func(r requestInfo) error {
+		done := make(chan error, 1)
+		go func() { done <- syncm.Sync(ctx, r) }()
+		for {
+			select {
+			case err := <-done:
+				return err
+			case <-time.After(10 * time.Millisecond):
+				c.Advance(syncBackoffMin)
+			}
+		}
+	}
+
+	// the peer is retried after backing off twice, without being penalized
+	start := c.Now()
+	require.NoError(t, syncBackingOff(requestInfo{nodes: []net.Peer{peer}, upTo: n}))
+	require.GreaterOrEqual(t, c.Since(start), 3*syncBackoffMin)
+	require.Equal(t, 3, client.requests[peer.Address()])
+	require.Empty(t, rep.Status())
+	require.Zero(t, syncm.backoff(peer))
+	last, err := mem.Last(ctx)
+	require.NoError(t, err)
+	require.Equal(t, n, last.Round)
+
+	// a peer that stays busy makes the sync fail
+	client.busy[peer.Address()] = syncBusyRetries + 1
+	require.ErrorIs(t, syncBackingOff(requestInfo{nodes: []net.Peer{peer}, upTo: n + 1}), ErrFailedAll)
+	require.NotZero(t, syncm.backoff(peer))
+}
@@ -5,14 +5,16 @@ import (
 
 	"github.com/drand/drand/common/scheme"
 	"github.com/drand/drand/key"
+	"github.com/drand/kyber/share"
 	"github.com/drand/kyber/util/random"
 )
 
 func BenchmarkVerifyBeacon(b *testing.B) {
-	secret := key.KeyGroup.Scalar().Pick(random.New())
-	public := key.KeyGroup.Point().Mul(secret, nil)
-
 	sch := scheme.GetSchemeFromEnv()
+	c := key.CryptoFor(sch)
+	secret := c.KeyGroup.Scalar().Pick(random.New())
+	public := c.KeyGroup.Point().Mul(secret, nil)
+
 	verifier := NewVerifier(sch)
 
 	var round uint64 = 16
@@ -20,7 +22,9 @@ func BenchmarkVerifyBeacon(b *testing.B) {
 
 	msg := verifier.DigestMessage(round, prevSig)
 
-	sig, _ := key.AuthScheme.Sign(secret, msg)
+	tsig, _ := c.ThresholdScheme.Sign(&share.PriShare{V: secret}, msg)
+	// the full signature is the partial of the only share, without its index
+	sig := tsig[2:]
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		b := Beacon{
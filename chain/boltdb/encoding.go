@@ -0,0 +1,70 @@
+package boltdb
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/drand/drand/chain"
+)
+
+// Beacons are stored with a compact, versioned binary layout:
+//
+//	version (1 byte) | round (8 bytes) | signature length (2 bytes) | signature | previous signature
+//
+// Integers are big-endian and the previous signature takes the rest of the
+// value, it is empty for unchained schemes. Values written by older versions of
+// drand are hexjson encoded: they always start with '{' so they can't be
+// mistaken for a versioned value.
+const (
+	beaconFormatV1 byte = 0x01
+
+	beaconHeaderLen = 1 + 8 + 2
+)
+
+// errInvalidBeaconValue is returned when a stored value can't be decoded
+var errInvalidBeaconValue = errors.New("invalid beacon value in database")
+
+func encodeBeacon(b *chain.Beacon) ([]byte, error) {
+	if len(b.Signature) > 0xFFFF {
+		return nil, fmt.Errorf("signature of round %d is too long: %d bytes", b.Round, len(b.Signature))
+	}
+
+	buff := make([]byte, beaconHeaderLen, beaconHeaderLen+len(b.Signature)+len(b.PreviousSig))
+	buff[0] = beaconFormatV1
+	binary.BigEndian.PutUint64(buff[1:9], b.Round)
+	binary.BigEndian.PutUint16(buff[9:11], uint16(len(b.Signature)))
+	buff = append(buff, b.Signature...)
+	buff = append(buff, b.PreviousSig...)
+	return buff, nil
+}
+
+// decodeBeacon reads both the binary format and the legacy hexjson one.
+func decodeBeacon(v []byte) (*chain.Beacon, error) {
+	b := &chain.Beacon{}
+	if isLegacyValue(v) {
+		err := b.Unmarshal(v)
+		return b, err
+	}
+
+	if len(v) < beaconHeaderLen || v[0] != beaconFormatV1 {
+		return b, errInvalidBeaconValue
+	}
+	sigLen := int(binary.BigEndian.Uint16(v[9:11]))
+	if len(v) < beaconHeaderLen+sigLen {
+		return b, errInvalidBeaconValue
+	}
+
+	b.Round = binary.BigEndian.Uint64(v[1:9])
+	// the value is only valid during the transaction, so we copy it out
+	b.Signature = append([]byte(nil), v[beaconHeaderLen:beaconHeaderLen+sigLen]...)
+	if prev := v[beaconHeaderLen+sigLen:]; len(prev) > 0 {
+		b.PreviousSig = append([]byte(nil), prev...)
+	}
+	return b, nil
+}
+
+// isLegacyValue returns true if v was written with the hexjson encoding
+func isLegacyValue(v []byte) bool {
+	return len(v) > 0 && v[0] == '{'
+}
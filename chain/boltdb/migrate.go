@@ -0,0 +1,83 @@
+package boltdb
+
+import (
+	"context"
+	"encoding/binary"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/drand/drand/chain"
+)
+
+// migrateBatchSize is the number of keys scanned in a single write
+// transaction during a migration. Keeping transactions small lets the beacon
+// process keep storing new beacons while the migration runs.
+const migrateBatchSize = 5000
+
+// Migrate rewrites all the beacons stored with the legacy JSON encoding using
+// the binary one. It works in small batches so it can run on a live database,
+// and it can be interrupted and resumed at any time since both encodings are
+// readable. The progress function, if any, is called after each batch with the
+// last round scanned. It returns the number of beacons rewritten.
+func (b *BoltStore) Migrate(ctx context.Context, progress func(round uint64)) (int, error) {
+	migrated := 0
+	from := chain.RoundToBytes(0)
+	for from != nil {
+		if err := ctx.Err(); err != nil {
+			return migrated, err
+		}
+
+		// keys are only valid during the transaction, so we keep the round
+		var lastRound uint64
+		scanned := 0
+		err := b.db.Update(func(tx *bolt.Tx) error {
+			bucket := tx.Bucket(beaconBucket)
+			cursor := bucket.Cursor()
+
+			var legacy []*chain.Beacon
+			for k, v := cursor.Seek(from); k != nil && scanned < migrateBatchSize; k, v = cursor.Next() {
+				scanned++
+				lastRound = binary.BigEndian.Uint64(k)
+				if !isLegacyValue(v) {
+					continue
+				}
+				beacon, err := decodeBeacon(v)
+				if err != nil {
+					return err
+				}
+				legacy = append(legacy, beacon)
+			}
+
+			// we don't modify the bucket while iterating over it since it could
+			// invalidate the cursor
+			for _, beacon := range legacy {
+				buff, err := encodeBeacon(beacon)
+				if err != nil {
+					return err
+				}
+				if err := bucket.Put(chain.RoundToBytes(beacon.Round), buff); err != nil {
+					return err
+				}
+			}
+			migrated += len(legacy)
+
+			if scanned < migrateBatchSize {
+				// we reached the end of the bucket
+				from = nil
+				return nil
+			}
+			from = chain.RoundToBytes(lastRound + 1)
+			return nil
+		})
+		if err != nil {
+			return migrated, err
+		}
+
+		if progress != nil && scanned > 0 {
+			progress(lastRound)
+		}
+	}
+
+	b.log.Infow("", "boltdb", "migration done", "migrated", migrated)
+	return migrated, nil
+}
@@ -2,7 +2,9 @@ package boltdb
 
 import (
 	"context"
+	"encoding/binary"
 	"io"
+	"os"
 	"path"
 	"sync"
 
@@ -14,8 +16,9 @@ import (
 )
 
 // BoltStore implements the Store interface using the kv storage boltdb (native
-// golang implementation). Internally, Beacons are stored as JSON-encoded in the
-// db file.
+// golang implementation). Internally, Beacons are stored with a compact binary
+// encoding in the db file, beacons stored as JSON by older versions are still
+// readable and can be rewritten with Migrate.
 //
 //nolint:gocritic// We do want to have a mutex here
 type BoltStore struct {
@@ -27,6 +30,21 @@ type BoltStore struct {
 
 var beaconBucket = []byte("beacons")
 
+// metaBucket holds the number of beacons stored and the range of rounds after
+// genesis they span, kept up to date by Put and Del so that Len and Range
+// don't have to scan the beacons
+var metaBucket = []byte("meta")
+
+// contributorsBucket holds the contributors of the rounds, keyed like the
+// beacons
+var contributorsBucket = []byte("contributors")
+
+var (
+	lenKey   = []byte("len")
+	firstKey = []byte("first")
+	lastKey  = []byte("last")
+)
+
 // BoltFileName is the name of the file boltdb writes to
 const BoltFileName = "drand.db"
 
@@ -40,10 +58,15 @@ func NewBoltStore(l log.Logger, folder string, opts *bolt.Options) (*BoltStore,
 	if err != nil {
 		return nil, err
 	}
-	// create the bucket already
+	// create the buckets already
 	err = db.Update(func(tx *bolt.Tx) error {
-		_, err := tx.CreateBucketIfNotExists(beaconBucket)
-		return err
+		if _, err := tx.CreateBucketIfNotExists(beaconBucket); err != nil {
+			return err
+		}
+		if _, err := tx.CreateBucketIfNotExists(contributorsBucket); err != nil {
+			return err
+		}
+		return initMeta(tx)
 	})
 
 	return &BoltStore{
@@ -52,13 +75,11 @@ func NewBoltStore(l log.Logger, folder string, opts *bolt.Options) (*BoltStore,
 	}, err
 }
 
-// Len performs a big scan over the bucket and is _very_ slow - use sparingly!
+// Len returns the number of beacons stored, genesis included
 func (b *BoltStore) Len(context.Context) (int, error) {
 	var length = 0
 	err := b.db.View(func(tx *bolt.Tx) error {
-		bucket := tx.Bucket(beaconBucket)
-		// this `.Stats()` call is the particularly expensive one!
-		length = bucket.Stats().KeyN
+		length = int(readMeta(tx, lenKey))
 		return nil
 	})
 	if err != nil {
@@ -67,6 +88,54 @@ func (b *BoltStore) Len(context.Context) (int, error) {
 	return length, err
 }
 
+// Range returns the lowest and highest rounds stored after genesis
+func (b *BoltStore) Range(context.Context) (first, last uint64, err error) {
+	err = b.db.View(func(tx *bolt.Tx) error {
+		if tx.Bucket(metaBucket).Get(lastKey) == nil {
+			return errors.ErrNoBeaconStored
+		}
+		first, last = readMeta(tx, firstKey), readMeta(tx, lastKey)
+		return nil
+	})
+	return first, last, err
+}
+
+// Gaps returns the ranges of rounds missing between the rounds returned by
+// Range. It only scans the database when the beacons stored don't already
+// cover the whole range.
+func (b *BoltStore) Gaps(context.Context) ([]chain.RoundRange, error) {
+	var gaps []chain.RoundRange
+	err := b.db.View(func(tx *bolt.Tx) error {
+		meta := tx.Bucket(metaBucket)
+		if meta.Get(lastKey) == nil {
+			return nil
+		}
+		first, last := readMeta(tx, firstKey), readMeta(tx, lastKey)
+
+		bucket := tx.Bucket(beaconBucket)
+		stored := readMeta(tx, lenKey)
+		if bucket.Get(chain.RoundToBytes(0)) != nil {
+			stored--
+		}
+		if stored == last-first+1 {
+			return nil
+		}
+
+		// we only look at the keys, the beacons don't need to be decoded
+		c := bucket.Cursor()
+		prev := first
+		for k, _ := c.Seek(chain.RoundToBytes(first + 1)); k != nil; k, _ = c.Next() {
+			round := binary.BigEndian.Uint64(k)
+			if round > prev+1 {
+				gaps = append(gaps, chain.RoundRange{From: prev + 1, To: round - 1})
+			}
+			prev = round
+		}
+		return nil
+	})
+	return gaps, err
+}
+
 func (b *BoltStore) Close(context.Context) error {
 	err := b.db.Close()
 	if err != nil {
@@ -81,11 +150,18 @@ func (b *BoltStore) Put(_ context.Context, beacon *chain.Beacon) error {
 	err := b.db.Update(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(beaconBucket)
 		key := chain.RoundToBytes(beacon.Round)
-		buff, err := beacon.Marshal()
+		buff, err := encodeBeacon(beacon)
 		if err != nil {
 			return err
 		}
-		return bucket.Put(key, buff)
+		existed := bucket.Get(key) != nil
+		if err := bucket.Put(key, buff); err != nil {
+			return err
+		}
+		if existed {
+			return nil
+		}
+		return putMeta(tx, beacon.Round)
 	})
 	return err
 }
@@ -100,7 +176,9 @@ func (b *BoltStore) Last(context.Context) (*chain.Beacon, error) {
 		if v == nil {
 			return errors.ErrNoBeaconStored
 		}
-		return beacon.Unmarshal(v)
+		var err error
+		beacon, err = decodeBeacon(v)
+		return err
 	})
 	return beacon, err
 }
@@ -114,7 +192,9 @@ func (b *BoltStore) Get(_ context.Context, round uint64) (*chain.Beacon, error)
 		if v == nil {
 			return errors.ErrNoBeaconStored
 		}
-		return beacon.Unmarshal(v)
+		var err error
+		beacon, err = decodeBeacon(v)
+		return err
 	})
 	return beacon, err
 }
@@ -122,10 +202,44 @@ func (b *BoltStore) Get(_ context.Context, round uint64) (*chain.Beacon, error)
 func (b *BoltStore) Del(_ context.Context, round uint64) error {
 	return b.db.Update(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(beaconBucket)
-		return bucket.Delete(chain.RoundToBytes(round))
+		key := chain.RoundToBytes(round)
+		if bucket.Get(key) == nil {
+			return nil
+		}
+		if err := bucket.Delete(key); err != nil {
+			return err
+		}
+		if err := tx.Bucket(contributorsBucket).Delete(key); err != nil {
+			return err
+		}
+		return delMeta(tx, round)
 	})
 }
 
+// PutContributors stores the contributors of a round
+func (b *BoltStore) PutContributors(_ context.Context, c *chain.Contributors) error {
+	buff, err := c.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(contributorsBucket).Put(chain.RoundToBytes(c.Round), buff)
+	})
+}
+
+// Contributors returns the contributors stored for this round
+func (b *BoltStore) Contributors(_ context.Context, round uint64) (*chain.Contributors, error) {
+	c := &chain.Contributors{Round: round}
+	err := b.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket(contributorsBucket).Get(chain.RoundToBytes(round))
+		if v == nil {
+			return errors.ErrNoContributorsStored
+		}
+		return c.UnmarshalBinary(v)
+	})
+	return c, err
+}
+
 func (b *BoltStore) Cursor(ctx context.Context, fn func(context.Context, chain.Cursor) error) error {
 	err := b.db.View(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(beaconBucket)
@@ -146,6 +260,126 @@ func (b *BoltStore) SaveTo(_ context.Context, w io.Writer) error {
 	})
 }
 
+// SaveStoreTo writes all the beacons of the given store to w as a bolt
+// database. It lets the engines that are not file based produce the same
+// backups as a BoltStore.
+func SaveStoreTo(ctx context.Context, l log.Logger, s chain.Store, w io.Writer) error {
+	tmp, err := os.MkdirTemp("", "drand-backup")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	bs, err := NewBoltStore(l, tmp, nil)
+	if err != nil {
+		return err
+	}
+	defer bs.Close(ctx)
+
+	err = s.Cursor(ctx, func(ctx context.Context, c chain.Cursor) error {
+		for b, err := c.First(ctx); b != nil; b, err = c.Next(ctx) {
+			if err != nil {
+				return err
+			}
+			if err := bs.Put(ctx, b); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return bs.SaveTo(ctx, w)
+}
+
+// initMeta fills the metadata bucket of a database created by a version of
+// drand that didn't maintain it. This is the only time we scan the beacons.
+func initMeta(tx *bolt.Tx) error {
+	meta, err := tx.CreateBucketIfNotExists(metaBucket)
+	if err != nil {
+		return err
+	}
+	if meta.Get(lenKey) != nil {
+		return nil
+	}
+
+	bucket := tx.Bucket(beaconBucket)
+	if err := writeMeta(meta, lenKey, uint64(bucket.Stats().KeyN)); err != nil {
+		return err
+	}
+	return refreshRange(tx)
+}
+
+// readMeta returns the value of a metadata key, 0 if it isn't set
+func readMeta(tx *bolt.Tx, key []byte) uint64 {
+	v := tx.Bucket(metaBucket).Get(key)
+	if len(v) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(v)
+}
+
+func writeMeta(meta *bolt.Bucket, key []byte, value uint64) error {
+	return meta.Put(key, chain.RoundToBytes(value))
+}
+
+// putMeta accounts for a new round stored in the beacons bucket
+func putMeta(tx *bolt.Tx, round uint64) error {
+	meta := tx.Bucket(metaBucket)
+	if err := writeMeta(meta, lenKey, readMeta(tx, lenKey)+1); err != nil {
+		return err
+	}
+	if round == 0 {
+		return nil
+	}
+
+	empty := meta.Get(lastKey) == nil
+	if empty || round < readMeta(tx, firstKey) {
+		if err := writeMeta(meta, firstKey, round); err != nil {
+			return err
+		}
+	}
+	if empty || round > readMeta(tx, lastKey) {
+		return writeMeta(meta, lastKey, round)
+	}
+	return nil
+}
+
+// delMeta accounts for a round deleted from the beacons bucket
+func delMeta(tx *bolt.Tx, round uint64) error {
+	meta := tx.Bucket(metaBucket)
+	if n := readMeta(tx, lenKey); n > 0 {
+		if err := writeMeta(meta, lenKey, n-1); err != nil {
+			return err
+		}
+	}
+	if round == 0 || (round != readMeta(tx, firstKey) && round != readMeta(tx, lastKey)) {
+		return nil
+	}
+	return refreshRange(tx)
+}
+
+// refreshRange looks up the lowest and highest rounds after genesis in the
+// beacons bucket, which bolt does without scanning it.
+func refreshRange(tx *bolt.Tx) error {
+	meta := tx.Bucket(metaBucket)
+	c := tx.Bucket(beaconBucket).Cursor()
+	first, _ := c.Seek(chain.RoundToBytes(1))
+	last, _ := c.Last()
+	if first == nil {
+		if err := meta.Delete(firstKey); err != nil {
+			return err
+		}
+		return meta.Delete(lastKey)
+	}
+	if err := writeMeta(meta, firstKey, binary.BigEndian.Uint64(first)); err != nil {
+		return err
+	}
+	return writeMeta(meta, lastKey, binary.BigEndian.Uint64(last))
+}
+
 type boltCursor struct {
 	*bolt.Cursor
 }
@@ -155,9 +389,7 @@ func (c *boltCursor) First(context.Context) (*chain.Beacon, error) {
 	if k == nil {
 		return nil, errors.ErrNoBeaconStored
 	}
-	b := &chain.Beacon{}
-	err := b.Unmarshal(v)
-	return b, err
+	return decodeBeacon(v)
 }
 
 func (c *boltCursor) Next(context.Context) (*chain.Beacon, error) {
@@ -165,9 +397,7 @@ func (c *boltCursor) Next(context.Context) (*chain.Beacon, error) {
 	if k == nil {
 		return nil, errors.ErrNoBeaconStored
 	}
-	b := &chain.Beacon{}
-	err := b.Unmarshal(v)
-	return b, err
+	return decodeBeacon(v)
 }
 
 func (c *boltCursor) Seek(_ context.Context, round uint64) (*chain.Beacon, error) {
@@ -175,9 +405,7 @@ func (c *boltCursor) Seek(_ context.Context, round uint64) (*chain.Beacon, error
 	if k == nil {
 		return nil, errors.ErrNoBeaconStored
 	}
-	b := &chain.Beacon{}
-	err := b.Unmarshal(v)
-	return b, err
+	return decodeBeacon(v)
 }
 
 func (c *boltCursor) Last(context.Context) (*chain.Beacon, error) {
@@ -185,7 +413,5 @@ func (c *boltCursor) Last(context.Context) (*chain.Beacon, error) {
 	if k == nil {
 		return nil, errors.ErrNoBeaconStored
 	}
-	b := &chain.Beacon{}
-	err := b.Unmarshal(v)
-	return b, err
+	return decodeBeacon(v)
 }
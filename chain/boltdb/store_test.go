@@ -1,151 +1,143 @@
 package boltdb
 
 import (
+	"bytes"
 	"context"
-	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
 
 	"github.com/drand/drand/chain"
-	chainerrors "github.com/drand/drand/chain/errors"
+	"github.com/drand/drand/chain/storetest"
 	"github.com/drand/drand/test"
 )
 
-func TestStoreBoltOrder(t *testing.T) {
-	tmp := t.TempDir()
-	ctx := context.Background()
-	l := test.Logger(t)
-	store, err := NewBoltStore(l, tmp, nil)
-	require.NoError(t, err)
+func TestStoreBolt(t *testing.T) {
+	storetest.Run(t, func(t *testing.T, folder string) chain.Store {
+		store, err := NewBoltStore(test.Logger(t), folder, nil)
+		require.NoError(t, err)
+		return store
+	})
+}
 
-	b1 := &chain.Beacon{
-		PreviousSig: []byte("a magnificent signature"),
+func TestStoreBoltEncoding(t *testing.T) {
+	chained := &chain.Beacon{
+		PreviousSig: bytes.Repeat([]byte{0x01}, 96),
 		Round:       145,
-		Signature:   []byte("one signature to"),
+		Signature:   bytes.Repeat([]byte{0x02}, 96),
 	}
-
-	b2 := &chain.Beacon{
-		PreviousSig: []byte("is not worth an invalid one"),
-		Round:       146,
-		Signature:   []byte("govern them all"),
+	unchained := &chain.Beacon{
+		Round:     146,
+		Signature: bytes.Repeat([]byte{0x03}, 96),
 	}
 
-	// we store b2 and check if it is last
-	require.NoError(t, store.Put(ctx, b2))
-	eb2, err := store.Last(ctx)
-	require.NoError(t, err)
-	require.Equal(t, b2, eb2)
-	eb2, err = store.Last(ctx)
-	require.NoError(t, err)
-	require.Equal(t, b2, eb2)
+	for _, b := range []*chain.Beacon{chained, unchained} {
+		buff, err := encodeBeacon(b)
+		require.NoError(t, err)
+		require.Len(t, buff, beaconHeaderLen+len(b.Signature)+len(b.PreviousSig))
 
-	// then we store b1
-	require.NoError(t, store.Put(ctx, b1))
+		decoded, err := decodeBeacon(buff)
+		require.NoError(t, err)
+		require.Equal(t, b, decoded)
 
-	// and request last again
-	eb2, err = store.Last(ctx)
-	require.NoError(t, err)
-	require.Equal(t, b2, eb2)
+		// the legacy format must still be readable
+		legacy, err := b.Marshal()
+		require.NoError(t, err)
+		require.Greater(t, len(legacy), len(buff))
+		decoded, err = decodeBeacon(legacy)
+		require.NoError(t, err)
+		require.True(t, b.Equal(decoded))
+	}
+
+	_, err := decodeBeacon([]byte{beaconFormatV1, 0x00})
+	require.Error(t, err)
+	_, err = decodeBeacon([]byte{0x42, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0})
+	require.Error(t, err)
 }
 
-func TestStoreBolt(t *testing.T) {
+func TestStoreBoltMigrate(t *testing.T) {
 	tmp := t.TempDir()
 	ctx := context.Background()
 	l := test.Logger(t)
-
-	var sig1 = []byte{0x01, 0x02, 0x03}
-	var sig2 = []byte{0x02, 0x03, 0x04}
-
 	store, err := NewBoltStore(l, tmp, nil)
 	require.NoError(t, err)
-
-	sLen, err := store.Len(ctx)
-	require.NoError(t, err)
-	require.Equal(t, 0, sLen)
-
-	b1 := &chain.Beacon{
-		PreviousSig: sig1,
-		Round:       145,
-		Signature:   sig2,
-	}
-
-	b2 := &chain.Beacon{
-		PreviousSig: sig2,
-		Round:       146,
-		Signature:   sig1,
-	}
-
-	require.NoError(t, store.Put(ctx, b1))
-	sLen, err = store.Len(ctx)
-	require.NoError(t, err)
-	require.Equal(t, 1, sLen)
-
-	require.NoError(t, store.Put(ctx, b1))
-	sLen, err = store.Len(ctx)
+	defer store.Close(ctx)
+
+	// write a database the way older versions did, with a few beacons
+	// already in the new format
+	n := uint64(2*migrateBatchSize + 10)
+	err = store.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(beaconBucket)
+		for i := uint64(0); i < n; i++ {
+			b := &chain.Beacon{Round: i, Signature: []byte{byte(i), byte(i >> 8)}, PreviousSig: []byte{byte(i)}}
+			buff, err := b.Marshal()
+			if i%3 == 0 {
+				buff, err = encodeBeacon(b)
+			}
+			if err != nil {
+				return err
+			}
+			if err := bucket.Put(chain.RoundToBytes(i), buff); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	require.NoError(t, err)
-	require.Equal(t, 1, sLen)
 
-	require.NoError(t, store.Put(ctx, b2))
-	sLen, err = store.Len(ctx)
+	b, err := store.Get(ctx, 4)
 	require.NoError(t, err)
-	require.Equal(t, 2, sLen)
+	require.Equal(t, uint64(4), b.Round)
 
-	received, err := store.Last(ctx)
+	var lastProgress uint64
+	migrated, err := store.Migrate(ctx, func(round uint64) {
+		require.Greater(t, round, lastProgress)
+		lastProgress = round
+	})
 	require.NoError(t, err)
-	require.Equal(t, b2, received)
+	require.Equal(t, int(n-(n+2)/3), migrated)
+	require.Equal(t, n-1, lastProgress)
 
-	err = store.Close(ctx)
+	err = store.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(beaconBucket).ForEach(func(k, v []byte) error {
+			require.False(t, isLegacyValue(v))
+			return nil
+		})
+	})
 	require.NoError(t, err)
 
-	store, err = NewBoltStore(l, tmp, nil)
+	b, err = store.Get(ctx, 4)
 	require.NoError(t, err)
-	require.NoError(t, store.Put(ctx, b1))
+	require.Equal(t, &chain.Beacon{Round: 4, Signature: []byte{4, 0}, PreviousSig: []byte{4}}, b)
 
-	require.NoError(t, store.Put(ctx, b1))
-	bb1, err := store.Get(ctx, b1.Round)
+	// migrating again is a no-op
+	migrated, err = store.Migrate(ctx, nil)
 	require.NoError(t, err)
-	require.Equal(t, b1, bb1)
-	store.Close(ctx)
+	require.Equal(t, 0, migrated)
+}
 
-	store, err = NewBoltStore(l, tmp, nil)
-	require.NoError(t, err)
-	err = store.Put(ctx, b1)
-	require.NoError(t, err)
-	err = store.Put(ctx, b2)
+func TestStoreBoltRange(t *testing.T) {
+	tmp := t.TempDir()
+	ctx := context.Background()
+	l := test.Logger(t)
+	store, err := NewBoltStore(l, tmp, nil)
 	require.NoError(t, err)
 
-	err = store.Cursor(ctx, func(ctx context.Context, c chain.Cursor) error {
-		expecteds := []*chain.Beacon{b1, b2}
-		i := 0
-		b, err := c.First(ctx)
-
-		for ; b != nil; b, err = c.Next(ctx) {
-			require.NoError(t, err)
-			require.True(t, expecteds[i].Equal(b))
-			i++
-		}
-		// Last iteration will always produce an ErrNoBeaconSaved value
-		if !errors.Is(err, chainerrors.ErrNoBeaconStored) {
-			require.NoError(t, err)
-		}
+	for i := uint64(0); i <= 9; i++ {
+		require.NoError(t, store.Put(ctx, &chain.Beacon{Round: i, Signature: []byte{byte(i)}}))
+	}
+	require.NoError(t, store.Del(ctx, 5))
+	storetest.CheckRange(t, store, 9, 1, 9, chain.RoundRange{From: 5, To: 5})
 
-		unknown, err := c.Seek(ctx, 10000)
-		require.ErrorIs(t, err, chainerrors.ErrNoBeaconStored)
-		require.Nil(t, unknown)
-		return nil
+	// the metadata is rebuilt for databases written by older versions
+	err = store.db.Update(func(tx *bolt.Tx) error {
+		return tx.DeleteBucket(metaBucket)
 	})
 	require.NoError(t, err)
-
-	err = store.Cursor(ctx, func(ctx context.Context, c chain.Cursor) error {
-		lb2, err := c.Last(ctx)
-		require.NoError(t, err)
-		require.NotNil(t, lb2)
-		require.Equal(t, b2, lb2)
-		return nil
-	})
+	require.NoError(t, store.Close(ctx))
+	store, err = NewBoltStore(l, tmp, nil)
 	require.NoError(t, err)
-
-	_, err = store.Get(ctx, 10000)
-	require.Equal(t, chainerrors.ErrNoBeaconStored, err)
+	defer store.Close(ctx)
+	storetest.CheckRange(t, store, 9, 1, 9, chain.RoundRange{From: 5, To: 5})
 }
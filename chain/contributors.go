@@ -0,0 +1,94 @@
+package chain
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// Contributors records which nodes' partial signatures were recovered into the
+// beacon of a round.
+type Contributors struct {
+	Round uint64
+	// Bitmap has the bit i set, starting from the lowest bit of the first
+	// byte, when the partial of the node at index i was used
+	Bitmap []byte
+	// Partials are the partial signatures used, ordered by index. They are
+	// only kept by the nodes configured to store them.
+	Partials [][]byte
+}
+
+// NewContributors returns the record of a round formed by the partials of the
+// given indexes, in a group of n nodes.
+func NewContributors(round uint64, n int, indexes []int) *Contributors {
+	bitmap := make([]byte, (n+7)/8)
+	for _, i := range indexes {
+		if i >= 0 && i < n {
+			bitmap[i/8] |= 1 << (i % 8)
+		}
+	}
+	return &Contributors{Round: round, Bitmap: bitmap}
+}
+
+// Has returns true if the node at the given index contributed
+func (c *Contributors) Has(index int) bool {
+	if index < 0 || index/8 >= len(c.Bitmap) {
+		return false
+	}
+	return c.Bitmap[index/8]&(1<<(index%8)) != 0
+}
+
+// Indexes returns the indexes of the nodes that contributed, in increasing
+// order
+func (c *Contributors) Indexes() []int {
+	var indexes []int
+	for i := 0; i < len(c.Bitmap)*8; i++ {
+		if c.Has(i) {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
+
+var errInvalidContributors = errors.New("invalid contributors encoding")
+
+// MarshalBinary encodes the bitmap and partials, the round being the key the
+// record is stored under. Each field is prefixed by its length on two bytes.
+func (c *Contributors) MarshalBinary() ([]byte, error) {
+	size := 2 + len(c.Bitmap)
+	for _, p := range c.Partials {
+		size += 2 + len(p)
+	}
+	buff := make([]byte, 0, size)
+	for _, field := range append([][]byte{c.Bitmap}, c.Partials...) {
+		if len(field) > math.MaxUint16 {
+			return nil, errInvalidContributors
+		}
+		buff = binary.BigEndian.AppendUint16(buff, uint16(len(field)))
+		buff = append(buff, field...)
+	}
+	return buff, nil
+}
+
+// UnmarshalBinary decodes the fields encoded by MarshalBinary, copying them
+// out of buff
+func (c *Contributors) UnmarshalBinary(buff []byte) error {
+	var fields [][]byte
+	for len(buff) > 0 {
+		if len(buff) < 2 {
+			return errInvalidContributors
+		}
+		l := int(binary.BigEndian.Uint16(buff))
+		if len(buff) < 2+l {
+			return errInvalidContributors
+		}
+		fields = append(fields, append([]byte(nil), buff[2:2+l]...))
+		buff = buff[2+l:]
+	}
+	if len(fields) == 0 {
+		return errInvalidContributors
+	}
+	c.Bitmap = fields[0]
+	c.Partials = fields[1:]
+	return nil
+}
@@ -0,0 +1,35 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestContributors(t *testing.T) {
+	c := NewContributors(12, 10, []int{9, 0, 3, 10, -1})
+	require.Equal(t, []byte{0x09, 0x02}, c.Bitmap)
+	require.Equal(t, []int{0, 3, 9}, c.Indexes())
+	require.True(t, c.Has(3))
+	require.False(t, c.Has(4))
+	require.False(t, c.Has(16))
+
+	c.Partials = [][]byte{[]byte("partial 0"), {}, []byte("partial 9")}
+	buff, err := c.MarshalBinary()
+	require.NoError(t, err)
+	decoded := &Contributors{Round: c.Round}
+	require.NoError(t, decoded.UnmarshalBinary(buff))
+	require.Equal(t, c.Bitmap, decoded.Bitmap)
+	require.Equal(t, []int{0, 3, 9}, decoded.Indexes())
+	require.Len(t, decoded.Partials, 3)
+	require.Equal(t, c.Partials[2], decoded.Partials[2])
+
+	// truncated encodings are refused
+	require.Error(t, decoded.UnmarshalBinary(buff[:len(buff)-1]))
+	require.Error(t, decoded.UnmarshalBinary(nil))
+
+	// and so are the contributors going through protobuf
+	fromProto := ContributorsFromProto(c.ToProto(nil))
+	require.Equal(t, c, fromProto)
+	require.Equal(t, []uint32{0, 3, 9}, c.ToProto(nil).GetIndexes())
+}
@@ -15,16 +15,16 @@ import (
 
 // InfoFromProto returns a Info from the protocol description
 func InfoFromProto(p *drand.ChainInfoPacket) (*Info, error) {
-	public := key.KeyGroup.Point()
-	if err := public.UnmarshalBinary(p.PublicKey); err != nil {
-		return nil, err
-	}
-
 	sch, err := scheme.GetSchemeByIDWithDefault(p.SchemeID)
 	if err != nil {
 		return nil, fmt.Errorf("scheme id received is not valid. Err: %w", err)
 	}
 
+	public := key.CryptoFor(sch).KeyGroup.Point()
+	if err := public.UnmarshalBinary(p.PublicKey); err != nil {
+		return nil, err
+	}
+
 	return &Info{
 		PublicKey:   public,
 		GenesisTime: p.GenesisTime,
@@ -76,3 +76,29 @@ func (c *Info) ToJSON(w io.Writer, metadata *common.Metadata) error {
 	info := c.ToProto(metadata)
 	return json.NewEncoder(w).Encode(info)
 }
+
+// ContributorsFromProto returns the contributors record of the protocol
+// description
+func ContributorsFromProto(p *drand.ContributorsResponse) *Contributors {
+	return &Contributors{
+		Round:    p.GetRound(),
+		Bitmap:   p.GetBitmap(),
+		Partials: p.GetPartials(),
+	}
+}
+
+// ToProto returns the protobuf description of the contributors record
+func (c *Contributors) ToProto(metadata *common.Metadata) *drand.ContributorsResponse {
+	indexes := c.Indexes()
+	protoIndexes := make([]uint32, len(indexes))
+	for i, idx := range indexes {
+		protoIndexes[i] = uint32(idx)
+	}
+	return &drand.ContributorsResponse{
+		Round:    c.Round,
+		Bitmap:   c.Bitmap,
+		Indexes:  protoIndexes,
+		Partials: c.Partials,
+		Metadata: metadata,
+	}
+}
@@ -9,3 +9,11 @@ var ErrNoBeaconStored = errors.New("no beacon stored above requested round")
 // ErrNoBeaconSaved is the error returned when no beacon have been saved in the
 // database yet.
 var ErrNoBeaconSaved = errors.New("beacon not found in database")
+
+// ErrBeaconPruned is the error returned when the requested beacon was deleted
+// from the database by the retention policy of the node.
+var ErrBeaconPruned = errors.New("beacon pruned from database")
+
+// ErrNoContributorsStored is the error returned when the contributors of a
+// round are not known, because the node didn't aggregate that beacon itself.
+var ErrNoContributorsStored = errors.New("no contributors stored for requested round")
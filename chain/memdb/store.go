@@ -0,0 +1,246 @@
+package memdb
+
+import (
+	"context"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/drand/drand/chain"
+	"github.com/drand/drand/chain/boltdb"
+	"github.com/drand/drand/chain/errors"
+	"github.com/drand/drand/log"
+)
+
+// Store implements the Store interface in memory. It only keeps the bufferSize
+// most recent beacons: once the buffer is full, every new beacon evicts the
+// oldest one. The genesis beacon is pinned and doesn't count in the buffer:
+// it is never evicted, so the chain can still be checked from its start.
+type Store struct {
+	storeMtx sync.RWMutex
+	// store is kept sorted by round, oldest beacon first. It holds the
+	// genesis beacon, if any, on top of the bufferSize most recent beacons.
+	store      []*chain.Beacon
+	bufferSize int
+	// contributors of the rounds held in the buffer
+	contributors map[uint64]*chain.Contributors
+
+	log log.Logger
+}
+
+// NewStore returns a Store implementation that keeps at most bufferSize
+// beacons in memory, plus the genesis beacon.
+func NewStore(l log.Logger, bufferSize int) *Store {
+	if bufferSize < 1 {
+		bufferSize = 1
+	}
+	return &Store{
+		store:        make([]*chain.Beacon, 0, bufferSize),
+		bufferSize:   bufferSize,
+		contributors: make(map[uint64]*chain.Contributors),
+		log:          l,
+	}
+}
+
+func (s *Store) Len(context.Context) (int, error) {
+	s.storeMtx.RLock()
+	defer s.storeMtx.RUnlock()
+
+	return len(s.store), nil
+}
+
+// Put implements the Store interface. Like the other stores, it overwrites a
+// beacon already saved for the same round. A beacon older than all the ones
+// held by a full buffer is dropped, unless it is the genesis beacon.
+func (s *Store) Put(_ context.Context, beacon *chain.Beacon) error {
+	s.storeMtx.Lock()
+	defer s.storeMtx.Unlock()
+
+	// fast path: the beacon extends the chain
+	if n := len(s.store); n == 0 || s.store[n-1].Round < beacon.Round {
+		if oldest := s.oldest(); n-oldest == s.bufferSize {
+			delete(s.contributors, s.store[oldest].Round)
+			copy(s.store[oldest:], s.store[oldest+1:])
+			s.store = s.store[:n-1]
+		}
+		s.store = append(s.store, beacon)
+		return nil
+	}
+
+	idx := s.search(beacon.Round)
+	if s.store[idx].Round == beacon.Round {
+		s.store[idx] = beacon
+		return nil
+	}
+
+	if oldest := s.oldest(); len(s.store)-oldest == s.bufferSize && beacon.Round != 0 {
+		if idx <= oldest {
+			return nil
+		}
+		// evict the oldest beacon to make room for this one
+		delete(s.contributors, s.store[oldest].Round)
+		copy(s.store[oldest:], s.store[oldest+1:idx])
+		s.store[idx-1] = beacon
+		return nil
+	}
+
+	s.store = append(s.store, nil)
+	copy(s.store[idx+1:], s.store[idx:])
+	s.store[idx] = beacon
+	return nil
+}
+
+// Last returns the last beacon saved in the buffer
+func (s *Store) Last(context.Context) (*chain.Beacon, error) {
+	s.storeMtx.RLock()
+	defer s.storeMtx.RUnlock()
+
+	if len(s.store) == 0 {
+		return &chain.Beacon{}, errors.ErrNoBeaconStored
+	}
+	return s.store[len(s.store)-1], nil
+}
+
+// Get returns the beacon saved at this round, if it is still in the buffer
+func (s *Store) Get(_ context.Context, round uint64) (*chain.Beacon, error) {
+	s.storeMtx.RLock()
+	defer s.storeMtx.RUnlock()
+
+	idx := s.search(round)
+	if idx == len(s.store) || s.store[idx].Round != round {
+		return &chain.Beacon{}, errors.ErrNoBeaconStored
+	}
+	return s.store[idx], nil
+}
+
+func (s *Store) Close(context.Context) error {
+	return nil
+}
+
+func (s *Store) Del(_ context.Context, round uint64) error {
+	s.storeMtx.Lock()
+	defer s.storeMtx.Unlock()
+
+	idx := s.search(round)
+	if idx == len(s.store) || s.store[idx].Round != round {
+		return nil
+	}
+	s.store = append(s.store[:idx], s.store[idx+1:]...)
+	delete(s.contributors, round)
+	return nil
+}
+
+// PutContributors keeps the contributors of a round as long as its beacon is
+// held in the buffer
+func (s *Store) PutContributors(_ context.Context, c *chain.Contributors) error {
+	s.storeMtx.Lock()
+	defer s.storeMtx.Unlock()
+
+	idx := s.search(c.Round)
+	if idx == len(s.store) || s.store[idx].Round != c.Round {
+		return nil
+	}
+	s.contributors[c.Round] = c
+	return nil
+}
+
+// Contributors returns the contributors of a round held in the buffer
+func (s *Store) Contributors(_ context.Context, round uint64) (*chain.Contributors, error) {
+	s.storeMtx.RLock()
+	defer s.storeMtx.RUnlock()
+
+	c, ok := s.contributors[round]
+	if !ok {
+		return &chain.Contributors{Round: round}, errors.ErrNoContributorsStored
+	}
+	return c, nil
+}
+
+// Cursor iterates over a snapshot of the buffer taken when it is called.
+func (s *Store) Cursor(ctx context.Context, fn func(context.Context, chain.Cursor) error) error {
+	s.storeMtx.RLock()
+	snapshot := make([]*chain.Beacon, len(s.store))
+	copy(snapshot, s.store)
+	s.storeMtx.RUnlock()
+
+	return fn(ctx, &memCursor{store: snapshot})
+}
+
+// SaveTo writes the beacons currently held in memory to w as a bolt database.
+func (s *Store) SaveTo(ctx context.Context, w io.Writer) error {
+	return boltdb.SaveStoreTo(ctx, s.log, s, w)
+}
+
+// Range returns the lowest and highest rounds held in the buffer after genesis
+func (s *Store) Range(context.Context) (first, last uint64, err error) {
+	s.storeMtx.RLock()
+	defer s.storeMtx.RUnlock()
+
+	idx := s.search(1)
+	if idx == len(s.store) {
+		return 0, 0, errors.ErrNoBeaconStored
+	}
+	return s.store[idx].Round, s.store[len(s.store)-1].Round, nil
+}
+
+// Gaps returns the ranges of rounds missing in the buffer
+func (s *Store) Gaps(context.Context) ([]chain.RoundRange, error) {
+	s.storeMtx.RLock()
+	defer s.storeMtx.RUnlock()
+
+	var gaps []chain.RoundRange
+	for i := s.search(1) + 1; i < len(s.store); i++ {
+		if prev := s.store[i-1].Round; s.store[i].Round > prev+1 {
+			gaps = append(gaps, chain.RoundRange{From: prev + 1, To: s.store[i].Round - 1})
+		}
+	}
+	return gaps, nil
+}
+
+// oldest returns the index of the oldest beacon that can be evicted, skipping
+// the genesis beacon. The caller must hold the lock.
+func (s *Store) oldest() int {
+	if len(s.store) > 0 && s.store[0].Round == 0 {
+		return 1
+	}
+	return 0
+}
+
+// search returns the index of the first beacon whose round is >= round. The
+// caller must hold the lock.
+func (s *Store) search(round uint64) int {
+	return sort.Search(len(s.store), func(i int) bool {
+		return s.store[i].Round >= round
+	})
+}
+
+type memCursor struct {
+	store []*chain.Beacon
+	pos   int
+}
+
+func (c *memCursor) First(context.Context) (*chain.Beacon, error) {
+	return c.at(0)
+}
+
+func (c *memCursor) Next(context.Context) (*chain.Beacon, error) {
+	return c.at(c.pos + 1)
+}
+
+func (c *memCursor) Seek(_ context.Context, round uint64) (*chain.Beacon, error) {
+	return c.at(sort.Search(len(c.store), func(i int) bool {
+		return c.store[i].Round >= round
+	}))
+}
+
+func (c *memCursor) Last(context.Context) (*chain.Beacon, error) {
+	return c.at(len(c.store) - 1)
+}
+
+func (c *memCursor) at(pos int) (*chain.Beacon, error) {
+	if pos < 0 || pos >= len(c.store) {
+		return nil, errors.ErrNoBeaconStored
+	}
+	c.pos = pos
+	return c.store[pos], nil
+}
@@ -0,0 +1,252 @@
+package memdb
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/chain"
+	chainerrors "github.com/drand/drand/chain/errors"
+	"github.com/drand/drand/chain/storetest"
+	"github.com/drand/drand/test"
+)
+
+// TestStoreMemDBConformance runs the tests every store must pass, with a
+// buffer large enough to never evict a beacon. A store lives in memory only:
+// opening a folder again gives back the store already opened for it.
+func TestStoreMemDBConformance(t *testing.T) {
+	stores := make(map[string]*Store)
+	storetest.Run(t, func(t *testing.T, folder string) chain.Store {
+		if _, ok := stores[folder]; !ok {
+			stores[folder] = NewStore(test.Logger(t), 100)
+		}
+		return stores[folder]
+	})
+}
+
+func TestStoreMemDB(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore(test.Logger(t), 10)
+
+	sLen, err := store.Len(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, sLen)
+
+	_, err = store.Last(ctx)
+	require.ErrorIs(t, err, chainerrors.ErrNoBeaconStored)
+
+	b1 := &chain.Beacon{
+		PreviousSig: []byte{0x01, 0x02, 0x03},
+		Round:       145,
+		Signature:   []byte{0x02, 0x03, 0x04},
+	}
+
+	b2 := &chain.Beacon{
+		PreviousSig: []byte{0x02, 0x03, 0x04},
+		Round:       146,
+		Signature:   []byte{0x01, 0x02, 0x03},
+	}
+
+	// out of order insertion still keeps the buffer sorted
+	require.NoError(t, store.Put(ctx, b2))
+	require.NoError(t, store.Put(ctx, b1))
+	require.NoError(t, store.Put(ctx, b1))
+	sLen, err = store.Len(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, sLen)
+
+	received, err := store.Last(ctx)
+	require.NoError(t, err)
+	require.Equal(t, b2, received)
+
+	bb1, err := store.Get(ctx, b1.Round)
+	require.NoError(t, err)
+	require.Equal(t, b1, bb1)
+
+	_, err = store.Get(ctx, 10000)
+	require.ErrorIs(t, err, chainerrors.ErrNoBeaconStored)
+
+	err = store.Cursor(ctx, func(ctx context.Context, c chain.Cursor) error {
+		expecteds := []*chain.Beacon{b1, b2}
+		i := 0
+		b, err := c.First(ctx)
+
+		for ; b != nil; b, err = c.Next(ctx) {
+			require.NoError(t, err)
+			require.True(t, expecteds[i].Equal(b))
+			i++
+		}
+		require.Equal(t, 2, i)
+		if !errors.Is(err, chainerrors.ErrNoBeaconStored) {
+			require.NoError(t, err)
+		}
+
+		sb, err := c.Seek(ctx, 100)
+		require.NoError(t, err)
+		require.Equal(t, b1, sb)
+
+		unknown, err := c.Seek(ctx, 10000)
+		require.ErrorIs(t, err, chainerrors.ErrNoBeaconStored)
+		require.Nil(t, unknown)
+
+		lb2, err := c.Last(ctx)
+		require.NoError(t, err)
+		require.Equal(t, b2, lb2)
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, store.Del(ctx, b2.Round))
+	received, err = store.Last(ctx)
+	require.NoError(t, err)
+	require.Equal(t, b1, received)
+}
+
+func TestStoreMemDBEviction(t *testing.T) {
+	ctx := context.Background()
+	bufferSize := 5
+	store := NewStore(test.Logger(t), bufferSize)
+
+	for i := uint64(0); i < 20; i++ {
+		require.NoError(t, store.Put(ctx, &chain.Beacon{Round: i, Signature: []byte{byte(i)}}))
+
+		sLen, err := store.Len(ctx)
+		require.NoError(t, err)
+		// the genesis beacon is kept on top of the buffer
+		require.LessOrEqual(t, sLen, bufferSize+1)
+
+		last, err := store.Last(ctx)
+		require.NoError(t, err)
+		require.Equal(t, i, last.Round)
+	}
+
+	// only the genesis and the 5 most recent rounds are kept
+	genesis, err := store.Get(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0}, genesis.Signature)
+	_, err = store.Get(ctx, 14)
+	require.ErrorIs(t, err, chainerrors.ErrNoBeaconStored)
+	for i := uint64(15); i < 20; i++ {
+		_, err := store.Get(ctx, i)
+		require.NoError(t, err)
+	}
+
+	// a beacon older than everything in a full buffer is dropped
+	require.NoError(t, store.Put(ctx, &chain.Beacon{Round: 3}))
+	_, err = store.Get(ctx, 3)
+	require.ErrorIs(t, err, chainerrors.ErrNoBeaconStored)
+
+	// filling a hole evicts the oldest beacon
+	require.NoError(t, store.Del(ctx, 17))
+	require.NoError(t, store.Put(ctx, &chain.Beacon{Round: 10}))
+	require.NoError(t, store.Put(ctx, &chain.Beacon{Round: 17}))
+	err = store.Cursor(ctx, func(ctx context.Context, c chain.Cursor) error {
+		var rounds []uint64
+		for b, _ := c.First(ctx); b != nil; b, _ = c.Next(ctx) {
+			rounds = append(rounds, b.Round)
+		}
+		require.Equal(t, []uint64{0, 15, 16, 17, 18, 19}, rounds)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestStoreMemDBRange(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore(test.Logger(t), 5)
+
+	_, _, err := store.Range(ctx)
+	require.ErrorIs(t, err, chainerrors.ErrNoBeaconStored)
+
+	for _, r := range []uint64{0, 1, 2, 5, 6} {
+		require.NoError(t, store.Put(ctx, &chain.Beacon{Round: r}))
+	}
+	first, last, err := store.Range(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), first)
+	require.Equal(t, uint64(6), last)
+	gaps, err := store.Gaps(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []chain.RoundRange{{From: 3, To: 4}}, gaps)
+
+	// evicting round 1 moves the start of the range, the genesis is kept
+	require.NoError(t, store.Put(ctx, &chain.Beacon{Round: 7}))
+	require.NoError(t, store.Put(ctx, &chain.Beacon{Round: 9}))
+	first, last, err = store.Range(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), first)
+	require.Equal(t, uint64(9), last)
+	gaps, err = store.Gaps(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []chain.RoundRange{{From: 3, To: 4}, {From: 8, To: 8}}, gaps)
+}
+
+func TestStoreMemDBGenesis(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore(test.Logger(t), 2)
+
+	// a genesis beacon put into a full buffer is kept, without evicting
+	// anything
+	require.NoError(t, store.Put(ctx, &chain.Beacon{Round: 1}))
+	require.NoError(t, store.Put(ctx, &chain.Beacon{Round: 2}))
+	require.NoError(t, store.Put(ctx, &chain.Beacon{Round: 0, Signature: []byte{0x01}}))
+	require.NoError(t, store.PutContributors(ctx, chain.NewContributors(0, 4, []int{0, 1})))
+	sLen, err := store.Len(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, sLen)
+
+	// and it survives the eviction of the rounds that follow it
+	for r := uint64(3); r < 10; r++ {
+		require.NoError(t, store.Put(ctx, &chain.Beacon{Round: r}))
+	}
+	require.NoError(t, store.Put(ctx, &chain.Beacon{Round: 5}))
+	genesis, err := store.Get(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x01}, genesis.Signature)
+	_, err = store.Contributors(ctx, 0)
+	require.NoError(t, err)
+
+	err = store.Cursor(ctx, func(ctx context.Context, c chain.Cursor) error {
+		first, err := c.First(ctx)
+		require.NoError(t, err)
+		require.Equal(t, genesis, first)
+		next, err := c.Next(ctx)
+		require.NoError(t, err)
+		require.Equal(t, uint64(8), next.Round)
+		return nil
+	})
+	require.NoError(t, err)
+
+	first, last, err := store.Range(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), first)
+	require.Equal(t, uint64(9), last)
+}
+
+func TestStoreMemDBContributors(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore(test.Logger(t), 2)
+
+	// the contributors of a round not held in the buffer are not kept
+	require.NoError(t, store.PutContributors(ctx, chain.NewContributors(1, 4, []int{0, 1})))
+	_, err := store.Contributors(ctx, 1)
+	require.ErrorIs(t, err, chainerrors.ErrNoContributorsStored)
+
+	require.NoError(t, store.Put(ctx, &chain.Beacon{Round: 1}))
+	require.NoError(t, store.Put(ctx, &chain.Beacon{Round: 2}))
+	require.NoError(t, store.PutContributors(ctx, chain.NewContributors(1, 4, []int{0, 1})))
+	require.NoError(t, store.PutContributors(ctx, chain.NewContributors(2, 4, []int{2, 3})))
+	c, err := store.Contributors(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, []int{2, 3}, c.Indexes())
+
+	// and they are evicted along with their beacon
+	require.NoError(t, store.Put(ctx, &chain.Beacon{Round: 3}))
+	_, err = store.Contributors(ctx, 1)
+	require.ErrorIs(t, err, chainerrors.ErrNoContributorsStored)
+	require.NoError(t, store.Del(ctx, 2))
+	_, err = store.Contributors(ctx, 2)
+	require.ErrorIs(t, err, chainerrors.ErrNoContributorsStored)
+}
@@ -0,0 +1,348 @@
+package postgresdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/lib/pq"
+
+	"github.com/drand/drand/chain"
+	"github.com/drand/drand/chain/boltdb"
+	chainerrors "github.com/drand/drand/chain/errors"
+	"github.com/drand/drand/log"
+)
+
+// PGStore implements the Store interface using a PostgreSQL database. All the
+// beacon ids running on a daemon can share the same database: each store only
+// sees the rows tagged with its own beacon id.
+type PGStore struct {
+	db       *sql.DB
+	beaconID string
+
+	log log.Logger
+}
+
+// driverName is the name under which lib/pq registers itself with database/sql
+const driverName = "postgres"
+
+const createTableQuery = `CREATE TABLE IF NOT EXISTS beacons (
+	beacon_id    TEXT   NOT NULL,
+	round        BIGINT NOT NULL,
+	signature    BYTEA  NOT NULL,
+	previous_sig BYTEA,
+	PRIMARY KEY (beacon_id, round)
+)`
+
+// the contributors of a round are deleted along with its beacon
+const createContributorsTableQuery = `CREATE TABLE IF NOT EXISTS contributors (
+	beacon_id TEXT    NOT NULL,
+	round     BIGINT  NOT NULL,
+	bitmap    BYTEA   NOT NULL,
+	partials  BYTEA[],
+	PRIMARY KEY (beacon_id, round),
+	FOREIGN KEY (beacon_id, round) REFERENCES beacons (beacon_id, round) ON DELETE CASCADE
+)`
+
+// the number of beacons stored for each beacon id, kept up to date by Put and
+// Del so that Len doesn't need to count the rows of the beacons table
+const createCountsTableQuery = `CREATE TABLE IF NOT EXISTS beacon_counts (
+	beacon_id TEXT   NOT NULL PRIMARY KEY,
+	length    BIGINT NOT NULL
+)`
+
+// NewPGStore returns a Store implementation using the PostgreSQL storage
+// engine. The dsn is handed as-is to the lib/pq driver and the beacons table is
+// created if it doesn't exist yet.
+func NewPGStore(ctx context.Context, l log.Logger, dsn, beaconID string) (*PGStore, error) {
+	db, err := sql.Open(driverName, dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("unable to reach postgres: %w", err)
+	}
+
+	if err := createSearchPathSchema(ctx, db); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("unable to create schema: %w", err)
+	}
+	if _, err := db.ExecContext(ctx, createTableQuery); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("unable to create beacons table: %w", err)
+	}
+	if _, err := db.ExecContext(ctx, createContributorsTableQuery); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("unable to create contributors table: %w", err)
+	}
+	if _, err := db.ExecContext(ctx, createCountsTableQuery); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("unable to create beacon_counts table: %w", err)
+	}
+	// databases written before the counter existed are counted once here
+	if _, err := db.ExecContext(ctx,
+		`INSERT INTO beacon_counts (beacon_id, length)
+		SELECT $1, COUNT(*) FROM beacons WHERE beacon_id = $1
+		ON CONFLICT (beacon_id) DO NOTHING`, beaconID); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("unable to initialize beacon count: %w", err)
+	}
+
+	return &PGStore{
+		db:       db,
+		beaconID: beaconID,
+		log:      l,
+	}, nil
+}
+
+// createSearchPathSchema creates the first schema of the search_path of the
+// connection, where the tables are created, if it doesn't exist yet. The
+// default "$user" schema is left alone.
+func createSearchPathSchema(ctx context.Context, db *sql.DB) error {
+	var searchPath string
+	if err := db.QueryRowContext(ctx, `SHOW search_path`).Scan(&searchPath); err != nil {
+		return err
+	}
+	schema := strings.Trim(strings.TrimSpace(strings.Split(searchPath, ",")[0]), `"`)
+	if schema == "" || schema == "$user" {
+		return nil
+	}
+	_, err := db.ExecContext(ctx, `CREATE SCHEMA IF NOT EXISTS `+pq.QuoteIdentifier(schema))
+	return err
+}
+
+// Len returns the number of beacons stored for this beacon id.
+func (p *PGStore) Len(ctx context.Context) (int, error) {
+	var length int
+	err := p.db.QueryRowContext(ctx,
+		`SELECT length FROM beacon_counts WHERE beacon_id = $1`, p.beaconID).Scan(&length)
+	if err != nil {
+		p.log.Warnw("", "postgresdb", "error getting length", "err", err)
+	}
+	return length, err
+}
+
+// Range returns the lowest and highest rounds stored after genesis
+func (p *PGStore) Range(ctx context.Context) (first, last uint64, err error) {
+	var minRound, maxRound sql.NullInt64
+	err = p.db.QueryRowContext(ctx,
+		`SELECT MIN(round), MAX(round) FROM beacons WHERE beacon_id = $1 AND round > 0`,
+		p.beaconID).Scan(&minRound, &maxRound)
+	if err != nil {
+		return 0, 0, err
+	}
+	if !minRound.Valid {
+		return 0, 0, chainerrors.ErrNoBeaconStored
+	}
+	return uint64(minRound.Int64), uint64(maxRound.Int64), nil
+}
+
+// Gaps returns the ranges of rounds missing between the rounds returned by
+// Range, letting the database compare each round with the next one.
+func (p *PGStore) Gaps(ctx context.Context) ([]chain.RoundRange, error) {
+	rows, err := p.db.QueryContext(ctx,
+		`SELECT round + 1, next_round - 1 FROM (
+			SELECT round, LEAD(round) OVER (ORDER BY round) AS next_round
+			FROM beacons WHERE beacon_id = $1 AND round > 0
+		) AS rounds WHERE next_round > round + 1 ORDER BY round`, p.beaconID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var gaps []chain.RoundRange
+	for rows.Next() {
+		var from, to int64
+		if err := rows.Scan(&from, &to); err != nil {
+			return nil, err
+		}
+		gaps = append(gaps, chain.RoundRange{From: uint64(from), To: uint64(to)})
+	}
+	return gaps, rows.Err()
+}
+
+func (p *PGStore) Close(context.Context) error {
+	err := p.db.Close()
+	if err != nil {
+		p.log.Errorw("", "postgresdb", "close", "err", err)
+	}
+	return err
+}
+
+// Put implements the Store interface. WARNING: It does NOT verify that this
+// beacon is not already saved in the database or not and will overwrite it.
+func (p *PGStore) Put(ctx context.Context, b *chain.Beacon) error {
+	return p.update(ctx, func(tx *sql.Tx) (int64, error) {
+		// xmax is only zero for a row inserted rather than updated
+		var inserted bool
+		err := tx.QueryRowContext(ctx,
+			`INSERT INTO beacons (beacon_id, round, signature, previous_sig) VALUES ($1, $2, $3, $4)
+			ON CONFLICT (beacon_id, round) DO UPDATE
+			SET signature = EXCLUDED.signature, previous_sig = EXCLUDED.previous_sig
+			RETURNING (xmax = 0)`,
+			p.beaconID, int64(b.Round), b.Signature, b.PreviousSig).Scan(&inserted)
+		if err != nil || !inserted {
+			return 0, err
+		}
+		return 1, nil
+	})
+}
+
+// Last returns the last beacon signature saved into the db
+func (p *PGStore) Last(ctx context.Context) (*chain.Beacon, error) {
+	return scanBeacon(p.db.QueryRowContext(ctx,
+		`SELECT round, signature, previous_sig FROM beacons
+		WHERE beacon_id = $1 ORDER BY round DESC LIMIT 1`, p.beaconID))
+}
+
+// Get returns the beacon saved at this round
+func (p *PGStore) Get(ctx context.Context, round uint64) (*chain.Beacon, error) {
+	return scanBeacon(p.db.QueryRowContext(ctx,
+		`SELECT round, signature, previous_sig FROM beacons
+		WHERE beacon_id = $1 AND round = $2`, p.beaconID, int64(round)))
+}
+
+func (p *PGStore) Del(ctx context.Context, round uint64) error {
+	return p.update(ctx, func(tx *sql.Tx) (int64, error) {
+		res, err := tx.ExecContext(ctx,
+			`DELETE FROM beacons WHERE beacon_id = $1 AND round = $2`, p.beaconID, int64(round))
+		if err != nil {
+			return 0, err
+		}
+		deleted, err := res.RowsAffected()
+		return -deleted, err
+	})
+}
+
+// update runs fn inside a transaction and adds the number of beacons it
+// returns to the count of this beacon id in that same transaction.
+func (p *PGStore) update(ctx context.Context, fn func(*sql.Tx) (int64, error)) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	//nolint:errcheck // rolling back a committed transaction is a no-op
+	defer tx.Rollback()
+
+	delta, err := fn(tx)
+	if err != nil {
+		return err
+	}
+	if delta != 0 {
+		if _, err := tx.ExecContext(ctx,
+			`UPDATE beacon_counts SET length = length + $2 WHERE beacon_id = $1`,
+			p.beaconID, delta); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// Cursor runs fn inside a read-only repeatable read transaction, so the cursor
+// sees a consistent snapshot of the chain, just like a bolt read transaction.
+func (p *PGStore) Cursor(ctx context.Context, fn func(context.Context, chain.Cursor) error) error {
+	tx, err := p.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		p.log.Warnw("", "postgresdb", "error getting cursor", "err", err)
+		return err
+	}
+	//nolint:errcheck // a read-only transaction has nothing to commit
+	defer tx.Rollback()
+
+	err = fn(ctx, &pgCursor{tx: tx, beaconID: p.beaconID})
+	if err != nil {
+		p.log.Warnw("", "postgresdb", "error getting cursor", "err", err)
+	}
+	return err
+}
+
+// PutContributors stores the contributors of a round, whose beacon must be
+// stored already.
+func (p *PGStore) PutContributors(ctx context.Context, c *chain.Contributors) error {
+	_, err := p.db.ExecContext(ctx,
+		`INSERT INTO contributors (beacon_id, round, bitmap, partials) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (beacon_id, round) DO UPDATE
+		SET bitmap = EXCLUDED.bitmap, partials = EXCLUDED.partials`,
+		p.beaconID, int64(c.Round), c.Bitmap, pq.ByteaArray(c.Partials))
+	return err
+}
+
+// Contributors returns the contributors stored for this round
+func (p *PGStore) Contributors(ctx context.Context, round uint64) (*chain.Contributors, error) {
+	c := &chain.Contributors{Round: round}
+	var partials pq.ByteaArray
+	err := p.db.QueryRowContext(ctx,
+		`SELECT bitmap, partials FROM contributors WHERE beacon_id = $1 AND round = $2`,
+		p.beaconID, int64(round)).Scan(&c.Bitmap, &partials)
+	if errors.Is(err, sql.ErrNoRows) {
+		return c, chainerrors.ErrNoContributorsStored
+	}
+	c.Partials = partials
+	return c, err
+}
+
+// SaveTo writes all the beacons of this beacon id to w as a bolt database, so
+// that backups taken from a postgres node can be used like any other backup.
+func (p *PGStore) SaveTo(ctx context.Context, w io.Writer) error {
+	return boltdb.SaveStoreTo(ctx, p.log, p, w)
+}
+
+type pgCursor struct {
+	tx       *sql.Tx
+	beaconID string
+	pos      uint64
+}
+
+func (c *pgCursor) First(ctx context.Context) (*chain.Beacon, error) {
+	return c.move(scanBeacon(c.tx.QueryRowContext(ctx,
+		`SELECT round, signature, previous_sig FROM beacons
+		WHERE beacon_id = $1 ORDER BY round ASC LIMIT 1`, c.beaconID)))
+}
+
+func (c *pgCursor) Next(ctx context.Context) (*chain.Beacon, error) {
+	return c.move(scanBeacon(c.tx.QueryRowContext(ctx,
+		`SELECT round, signature, previous_sig FROM beacons
+		WHERE beacon_id = $1 AND round > $2 ORDER BY round ASC LIMIT 1`, c.beaconID, int64(c.pos))))
+}
+
+func (c *pgCursor) Seek(ctx context.Context, round uint64) (*chain.Beacon, error) {
+	return c.move(scanBeacon(c.tx.QueryRowContext(ctx,
+		`SELECT round, signature, previous_sig FROM beacons
+		WHERE beacon_id = $1 AND round >= $2 ORDER BY round ASC LIMIT 1`, c.beaconID, int64(round))))
+}
+
+func (c *pgCursor) Last(ctx context.Context) (*chain.Beacon, error) {
+	return c.move(scanBeacon(c.tx.QueryRowContext(ctx,
+		`SELECT round, signature, previous_sig FROM beacons
+		WHERE beacon_id = $1 ORDER BY round DESC LIMIT 1`, c.beaconID)))
+}
+
+// move records the position of the cursor on the returned beacon, if any
+func (c *pgCursor) move(b *chain.Beacon, err error) (*chain.Beacon, error) {
+	if err != nil {
+		return nil, err
+	}
+	c.pos = b.Round
+	return b, nil
+}
+
+// scanBeacon always returns a non-nil beacon, like the BoltStore does, since
+// some wrappers dereference the result of Last even when it failed.
+func scanBeacon(row *sql.Row) (*chain.Beacon, error) {
+	var round int64
+	b := &chain.Beacon{}
+	err := row.Scan(&round, &b.Signature, &b.PreviousSig)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &chain.Beacon{}, chainerrors.ErrNoBeaconStored
+	}
+	if err != nil {
+		return &chain.Beacon{}, err
+	}
+	b.Round = uint64(round)
+	return b, nil
+}
@@ -0,0 +1,273 @@
+package postgresdb
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/drand/drand/chain"
+	chainerrors "github.com/drand/drand/chain/errors"
+	"github.com/drand/drand/chain/storetest"
+	"github.com/drand/drand/test"
+)
+
+// testDSN returns the database given in DRAND_TEST_PG_DSN. The test is skipped
+// when no database is available.
+func testDSN(t *testing.T) string {
+	dsn := os.Getenv("DRAND_TEST_PG_DSN")
+	if dsn == "" {
+		t.Skip("DRAND_TEST_PG_DSN not set, skipping PostgreSQL tests")
+	}
+	return dsn
+}
+
+// newTestStore returns a store holding no beacon for this beacon id. Its rows
+// are deleted again at the end of the test.
+func newTestStore(t *testing.T, beaconID string) *PGStore {
+	dsn := testDSN(t)
+	ctx := context.Background()
+	store := openTestStore(t, dsn, beaconID)
+
+	_, err := store.db.ExecContext(ctx, `DELETE FROM beacons WHERE beacon_id = $1`, beaconID)
+	require.NoError(t, err)
+	_, err = store.db.ExecContext(ctx, `UPDATE beacon_counts SET length = 0 WHERE beacon_id = $1`, beaconID)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		// the store may be closed by the test already
+		db, err := sql.Open(driverName, dsn)
+		if err != nil {
+			return
+		}
+		defer db.Close()
+		_, _ = db.ExecContext(ctx, `DELETE FROM beacons WHERE beacon_id = $1`, beaconID)
+		_, _ = db.ExecContext(ctx, `DELETE FROM beacon_counts WHERE beacon_id = $1`, beaconID)
+	})
+
+	return store
+}
+
+// openTestStore opens the store of this beacon id as it is
+func openTestStore(t *testing.T, dsn, beaconID string) *PGStore {
+	ctx := context.Background()
+	store, err := NewPGStore(ctx, test.Logger(t), dsn, beaconID)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close(ctx) })
+	return store
+}
+
+// TestStorePGConformance runs the tests every persistent store must pass. Each
+// folder given by the suite stands for a beacon id: opening it again reopens
+// the rows written for it.
+func TestStorePGConformance(t *testing.T) {
+	dsn := testDSN(t)
+	opened := make(map[string]bool)
+	storetest.Run(t, func(t *testing.T, folder string) chain.Store {
+		if opened[folder] {
+			return openTestStore(t, dsn, folder)
+		}
+		opened[folder] = true
+		return newTestStore(t, folder)
+	})
+}
+
+func TestStorePG(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t, t.Name())
+
+	var sig1 = []byte{0x01, 0x02, 0x03}
+	var sig2 = []byte{0x02, 0x03, 0x04}
+
+	sLen, err := store.Len(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, sLen)
+
+	_, err = store.Last(ctx)
+	require.ErrorIs(t, err, chainerrors.ErrNoBeaconStored)
+
+	b1 := &chain.Beacon{
+		PreviousSig: sig1,
+		Round:       145,
+		Signature:   sig2,
+	}
+
+	b2 := &chain.Beacon{
+		PreviousSig: sig2,
+		Round:       146,
+		Signature:   sig1,
+	}
+
+	require.NoError(t, store.Put(ctx, b2))
+	require.NoError(t, store.Put(ctx, b1))
+	require.NoError(t, store.Put(ctx, b1))
+	sLen, err = store.Len(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, sLen)
+
+	received, err := store.Last(ctx)
+	require.NoError(t, err)
+	require.Equal(t, b2, received)
+
+	bb1, err := store.Get(ctx, b1.Round)
+	require.NoError(t, err)
+	require.Equal(t, b1, bb1)
+
+	_, err = store.Get(ctx, 10000)
+	require.ErrorIs(t, err, chainerrors.ErrNoBeaconStored)
+
+	err = store.Cursor(ctx, func(ctx context.Context, c chain.Cursor) error {
+		expecteds := []*chain.Beacon{b1, b2}
+		i := 0
+		b, err := c.First(ctx)
+
+		for ; b != nil; b, err = c.Next(ctx) {
+			require.NoError(t, err)
+			require.True(t, expecteds[i].Equal(b))
+			i++
+		}
+		require.Equal(t, 2, i)
+		// Last iteration will always produce an ErrNoBeaconSaved value
+		if !errors.Is(err, chainerrors.ErrNoBeaconStored) {
+			require.NoError(t, err)
+		}
+
+		sb2, err := c.Seek(ctx, 146)
+		require.NoError(t, err)
+		require.Equal(t, b2, sb2)
+
+		unknown, err := c.Seek(ctx, 10000)
+		require.ErrorIs(t, err, chainerrors.ErrNoBeaconStored)
+		require.Nil(t, unknown)
+
+		lb2, err := c.Last(ctx)
+		require.NoError(t, err)
+		require.Equal(t, b2, lb2)
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, store.Del(ctx, b2.Round))
+	require.NoError(t, store.Del(ctx, 10000))
+	received, err = store.Last(ctx)
+	require.NoError(t, err)
+	require.Equal(t, b1, received)
+	sLen, err = store.Len(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, sLen)
+}
+
+func TestStorePGCount(t *testing.T) {
+	ctx := context.Background()
+	dsn := testDSN(t)
+	store := newTestStore(t, t.Name())
+
+	for i := uint64(0); i <= 5; i++ {
+		require.NoError(t, store.Put(ctx, &chain.Beacon{Round: i, Signature: []byte{byte(i)}}))
+	}
+	require.NoError(t, store.Put(ctx, &chain.Beacon{Round: 3, Signature: []byte{0x03}}))
+	require.NoError(t, store.Del(ctx, 2))
+	storetest.CheckRange(t, store, 5, 1, 5, chain.RoundRange{From: 2, To: 2})
+
+	// a failed write leaves the count alone
+	require.Error(t, store.Put(ctx, &chain.Beacon{Round: 6}))
+	storetest.CheckRange(t, store, 5, 1, 5, chain.RoundRange{From: 2, To: 2})
+
+	// the beacons of a database written before the count existed are counted
+	// when the store is opened
+	_, err := store.db.ExecContext(ctx, `DELETE FROM beacon_counts WHERE beacon_id = $1`, t.Name())
+	require.NoError(t, err)
+	require.NoError(t, store.Close(ctx))
+	store = openTestStore(t, dsn, t.Name())
+	storetest.CheckRange(t, store, 5, 1, 5, chain.RoundRange{From: 2, To: 2})
+}
+
+func TestStorePGBeaconIDs(t *testing.T) {
+	ctx := context.Background()
+	s1 := newTestStore(t, t.Name()+"-1")
+	s2 := newTestStore(t, t.Name()+"-2")
+
+	require.NoError(t, s1.Put(ctx, &chain.Beacon{Round: 1, Signature: []byte("one")}))
+
+	sLen, err := s2.Len(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, sLen)
+
+	_, err = s2.Get(ctx, 1)
+	require.ErrorIs(t, err, chainerrors.ErrNoBeaconStored)
+}
+
+func TestStorePGSaveTo(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t, t.Name())
+
+	for i := uint64(0); i < 10; i++ {
+		require.NoError(t, store.Put(ctx, &chain.Beacon{Round: i, Signature: []byte{byte(i)}}))
+	}
+
+	var buff bytes.Buffer
+	require.NoError(t, store.SaveTo(ctx, &buff))
+
+	// the backup must be a valid bolt database holding all the beacons
+	backup := t.TempDir() + "/backup.db"
+	require.NoError(t, os.WriteFile(backup, buff.Bytes(), 0o600))
+	db, err := bolt.Open(backup, 0o600, nil)
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, db.View(func(tx *bolt.Tx) error {
+		require.Equal(t, 10, tx.Bucket([]byte("beacons")).Stats().KeyN)
+		return nil
+	}))
+}
+
+func TestStorePGRange(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t, t.Name())
+
+	_, _, err := store.Range(ctx)
+	require.ErrorIs(t, err, chainerrors.ErrNoBeaconStored)
+	gaps, err := store.Gaps(ctx)
+	require.NoError(t, err)
+	require.Empty(t, gaps)
+
+	for _, r := range []uint64{0, 2, 3, 6, 9, 10} {
+		require.NoError(t, store.Put(ctx, &chain.Beacon{Round: r, Signature: []byte{byte(r)}}))
+	}
+	first, last, err := store.Range(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), first)
+	require.Equal(t, uint64(10), last)
+	gaps, err = store.Gaps(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []chain.RoundRange{{From: 4, To: 5}, {From: 7, To: 8}}, gaps)
+}
+
+func TestStorePGContributors(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t, t.Name())
+
+	_, err := store.Contributors(ctx, 1)
+	require.ErrorIs(t, err, chainerrors.ErrNoContributorsStored)
+
+	// the contributors of a round need its beacon
+	c := chain.NewContributors(1, 10, []int{0, 3, 9})
+	c.Partials = [][]byte{[]byte("partial 0"), []byte("partial 3"), []byte("partial 9")}
+	require.Error(t, store.PutContributors(ctx, c))
+
+	require.NoError(t, store.Put(ctx, &chain.Beacon{Round: 1, Signature: []byte{0x01}}))
+	require.NoError(t, store.PutContributors(ctx, c))
+
+	got, err := store.Contributors(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, []int{0, 3, 9}, got.Indexes())
+	require.Equal(t, c.Partials, got.Partials)
+
+	// deleting the beacon deletes its contributors
+	require.NoError(t, store.Del(ctx, 1))
+	_, err = store.Contributors(ctx, 1)
+	require.ErrorIs(t, err, chainerrors.ErrNoContributorsStored)
+}
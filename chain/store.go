@@ -9,7 +9,26 @@ import (
 
 // store contains all the definitions and implementation of the logic that
 // stores and loads beacon signatures. At the moment of writing, it consists of
-// a boltdb key/value database store.
+// a boltdb or badger key/value database store, a PostgreSQL database or a
+// bounded in-memory buffer.
+
+// StorageType defines the supported storage engines
+type StorageType string
+
+// Storage engine types
+const (
+	// BoltDB uses the BoltDB engine for storing data
+	BoltDB StorageType = "bolt"
+
+	// Badger uses the Badger engine for storing data
+	Badger StorageType = "badger"
+
+	// PostgreSQL uses the PostgreSQL database for storing data
+	PostgreSQL StorageType = "postgres"
+
+	// MemDB uses the in-memory database for storing data
+	MemDB StorageType = "memdb"
+)
 
 // Store is an interface to store Beacons packets where they can also be
 // retrieved to be delivered to end clients.
@@ -22,6 +41,29 @@ type Store interface {
 	Close(context.Context) error
 	Del(ctx context.Context, round uint64) error
 	SaveTo(ctx context.Context, w io.Writer) error
+	// Range returns the lowest and highest rounds stored after the genesis
+	// beacon. It returns ErrNoBeaconStored when no such round is stored.
+	Range(context.Context) (first, last uint64, err error)
+	// Gaps returns the ranges of rounds missing between the rounds returned
+	// by Range, in increasing order. A store holding no round has no gap.
+	Gaps(context.Context) ([]RoundRange, error)
+	// PutContributors stores which nodes contributed to the beacon of a round.
+	// The record is deleted along with the beacon.
+	PutContributors(context.Context, *Contributors) error
+	// Contributors returns the record stored for the given round. It returns
+	// ErrNoContributorsStored when there is none.
+	Contributors(ctx context.Context, round uint64) (*Contributors, error)
+}
+
+// RoundRange is an inclusive range of rounds
+type RoundRange struct {
+	From uint64
+	To   uint64
+}
+
+// Len returns the number of rounds in the range
+func (r RoundRange) Len() uint64 {
+	return r.To - r.From + 1
 }
 
 // Cursor iterates over items in sorted key order. This starts from the
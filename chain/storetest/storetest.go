@@ -0,0 +1,278 @@
+// Package storetest holds the tests every chain.Store engine must
+// pass, so that the engines are checked against the same behaviour.
+package storetest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/chain"
+	chainerrors "github.com/drand/drand/chain/errors"
+)
+
+// Opener opens the store written in the given folder. Opening the same folder
+// again after closing the store must give back the beacons stored.
+type Opener func(t *testing.T, folder string) chain.Store
+
+// Run runs the whole test suite against the stores returned by open
+func Run(t *testing.T, open Opener) {
+	t.Run("Order", func(t *testing.T) { TestOrder(t, open) })
+	t.Run("Store", func(t *testing.T) { TestStore(t, open) })
+	t.Run("Range", func(t *testing.T) { TestRange(t, open) })
+	t.Run("Contributors", func(t *testing.T) { TestContributors(t, open) })
+}
+
+// TestOrder checks that the last beacon is the one with the highest round, not
+// the last one stored.
+func TestOrder(t *testing.T, open Opener) {
+	ctx := context.Background()
+	store := open(t, t.TempDir())
+	defer store.Close(ctx)
+
+	b1 := &chain.Beacon{
+		PreviousSig: []byte("a magnificent signature"),
+		Round:       145,
+		Signature:   []byte("one signature to"),
+	}
+
+	b2 := &chain.Beacon{
+		PreviousSig: []byte("is not worth an invalid one"),
+		Round:       146,
+		Signature:   []byte("govern them all"),
+	}
+
+	// we store b2 and check if it is last
+	require.NoError(t, store.Put(ctx, b2))
+	eb2, err := store.Last(ctx)
+	require.NoError(t, err)
+	require.Equal(t, b2, eb2)
+	eb2, err = store.Last(ctx)
+	require.NoError(t, err)
+	require.Equal(t, b2, eb2)
+
+	// then we store b1
+	require.NoError(t, store.Put(ctx, b1))
+
+	// and request last again
+	eb2, err = store.Last(ctx)
+	require.NoError(t, err)
+	require.Equal(t, b2, eb2)
+}
+
+// TestStore checks storing, reading and iterating over beacons, and that they
+// are persisted when the store is opened again.
+func TestStore(t *testing.T, open Opener) {
+	tmp := t.TempDir()
+	ctx := context.Background()
+
+	var sig1 = []byte{0x01, 0x02, 0x03}
+	var sig2 = []byte{0x02, 0x03, 0x04}
+
+	store := open(t, tmp)
+
+	sLen, err := store.Len(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, sLen)
+
+	b1 := &chain.Beacon{
+		PreviousSig: sig1,
+		Round:       145,
+		Signature:   sig2,
+	}
+
+	b2 := &chain.Beacon{
+		PreviousSig: sig2,
+		Round:       146,
+		Signature:   sig1,
+	}
+
+	require.NoError(t, store.Put(ctx, b1))
+	sLen, err = store.Len(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, sLen)
+
+	require.NoError(t, store.Put(ctx, b1))
+	sLen, err = store.Len(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, sLen)
+
+	require.NoError(t, store.Put(ctx, b2))
+	sLen, err = store.Len(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, sLen)
+
+	received, err := store.Last(ctx)
+	require.NoError(t, err)
+	require.Equal(t, b2, received)
+
+	err = store.Close(ctx)
+	require.NoError(t, err)
+
+	store = open(t, tmp)
+	require.NoError(t, store.Put(ctx, b1))
+
+	require.NoError(t, store.Put(ctx, b1))
+	bb1, err := store.Get(ctx, b1.Round)
+	require.NoError(t, err)
+	require.Equal(t, b1, bb1)
+	store.Close(ctx)
+
+	store = open(t, tmp)
+	defer store.Close(ctx)
+	err = store.Put(ctx, b1)
+	require.NoError(t, err)
+	err = store.Put(ctx, b2)
+	require.NoError(t, err)
+
+	err = store.Cursor(ctx, func(ctx context.Context, c chain.Cursor) error {
+		expecteds := []*chain.Beacon{b1, b2}
+		i := 0
+		b, err := c.First(ctx)
+
+		for ; b != nil; b, err = c.Next(ctx) {
+			require.NoError(t, err)
+			require.True(t, expecteds[i].Equal(b))
+			i++
+		}
+		// Last iteration will always produce an ErrNoBeaconSaved value
+		if !errors.Is(err, chainerrors.ErrNoBeaconStored) {
+			require.NoError(t, err)
+		}
+
+		unknown, err := c.Seek(ctx, 10000)
+		require.ErrorIs(t, err, chainerrors.ErrNoBeaconStored)
+		require.Nil(t, unknown)
+		return nil
+	})
+	require.NoError(t, err)
+
+	err = store.Cursor(ctx, func(ctx context.Context, c chain.Cursor) error {
+		lb2, err := c.Last(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, lb2)
+		require.Equal(t, b2, lb2)
+		return nil
+	})
+	require.NoError(t, err)
+
+	_, err = store.Get(ctx, 10000)
+	require.Equal(t, chainerrors.ErrNoBeaconStored, err)
+}
+
+// TestRange checks that the length, range and gaps of the store follow the
+// beacons stored and deleted, and that they are persisted.
+func TestRange(t *testing.T, open Opener) {
+	tmp := t.TempDir()
+	ctx := context.Background()
+	store := open(t, tmp)
+
+	_, _, err := store.Range(ctx)
+	require.ErrorIs(t, err, chainerrors.ErrNoBeaconStored)
+	gaps, err := store.Gaps(ctx)
+	require.NoError(t, err)
+	require.Empty(t, gaps)
+
+	for i := uint64(0); i <= 10; i++ {
+		if i == 4 || i == 7 || i == 8 {
+			continue
+		}
+		require.NoError(t, store.Put(ctx, &chain.Beacon{Round: i, Signature: []byte{byte(i)}}))
+	}
+	// overwriting a beacon doesn't change the length
+	require.NoError(t, store.Put(ctx, &chain.Beacon{Round: 5, Signature: []byte{0x05}}))
+	// neither does deleting a missing one
+	require.NoError(t, store.Del(ctx, 4))
+	CheckRange(t, store, 8, 1, 10, chain.RoundRange{From: 4, To: 4}, chain.RoundRange{From: 7, To: 8})
+
+	require.NoError(t, store.Del(ctx, 10))
+	require.NoError(t, store.Del(ctx, 1))
+	CheckRange(t, store, 6, 2, 9, chain.RoundRange{From: 4, To: 4}, chain.RoundRange{From: 7, To: 8})
+
+	require.NoError(t, store.Put(ctx, &chain.Beacon{Round: 4}))
+	require.NoError(t, store.Put(ctx, &chain.Beacon{Round: 7}))
+	require.NoError(t, store.Put(ctx, &chain.Beacon{Round: 8}))
+	CheckRange(t, store, 9, 2, 9)
+
+	// the metadata is persisted
+	require.NoError(t, store.Close(ctx))
+	store = open(t, tmp)
+	defer store.Close(ctx)
+	CheckRange(t, store, 9, 2, 9)
+
+	// removing every round after genesis empties the range
+	for i := uint64(2); i <= 9; i++ {
+		require.NoError(t, store.Del(ctx, i))
+	}
+	_, _, err = store.Range(ctx)
+	require.ErrorIs(t, err, chainerrors.ErrNoBeaconStored)
+	sLen, err := store.Len(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, sLen)
+}
+
+// TestContributors checks that the contributors of a round are stored next to
+// its beacon and deleted along with it.
+func TestContributors(t *testing.T, open Opener) {
+	tmp := t.TempDir()
+	ctx := context.Background()
+	store := open(t, tmp)
+
+	_, err := store.Contributors(ctx, 1)
+	require.ErrorIs(t, err, chainerrors.ErrNoContributorsStored)
+
+	require.NoError(t, store.Put(ctx, &chain.Beacon{Round: 1, Signature: []byte{0x01}}))
+	require.NoError(t, store.Put(ctx, &chain.Beacon{Round: 2, Signature: []byte{0x02}}))
+	c1 := chain.NewContributors(1, 10, []int{0, 3, 9})
+	c2 := chain.NewContributors(2, 10, []int{1, 2})
+	c2.Partials = [][]byte{[]byte("partial 1"), []byte("partial 2")}
+	require.NoError(t, store.PutContributors(ctx, c1))
+	require.NoError(t, store.PutContributors(ctx, c2))
+	// the contributors don't count as beacons
+	CheckRange(t, store, 2, 1, 2)
+
+	// the contributors are persisted
+	require.NoError(t, store.Close(ctx))
+	store = open(t, tmp)
+	defer store.Close(ctx)
+
+	got, err := store.Contributors(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), got.Round)
+	require.Equal(t, []int{0, 3, 9}, got.Indexes())
+	require.Empty(t, got.Partials)
+	got, err = store.Contributors(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, c2.Bitmap, got.Bitmap)
+	require.Equal(t, c2.Partials, got.Partials)
+
+	// deleting a beacon deletes its contributors
+	require.NoError(t, store.Del(ctx, 1))
+	_, err = store.Contributors(ctx, 1)
+	require.ErrorIs(t, err, chainerrors.ErrNoContributorsStored)
+	_, err = store.Contributors(ctx, 2)
+	require.NoError(t, err)
+	CheckRange(t, store, 1, 2, 2)
+
+	// overwriting a beacon with contributors doesn't change the length
+	require.NoError(t, store.Put(ctx, &chain.Beacon{Round: 2, Signature: []byte{0x02}}))
+	CheckRange(t, store, 1, 2, 2)
+}
+
+// CheckRange checks the length, range and gaps reported by the store
+func CheckRange(t *testing.T, store chain.Store, length int, first, last uint64, gaps ...chain.RoundRange) {
+	t.Helper()
+	ctx := context.Background()
+	sLen, err := store.Len(ctx)
+	require.NoError(t, err)
+	require.Equal(t, length, sLen)
+	f, l, err := store.Range(ctx)
+	require.NoError(t, err)
+	require.Equal(t, first, f)
+	require.Equal(t, last, l)
+	g, err := store.Gaps(ctx)
+	require.NoError(t, err)
+	require.Equal(t, gaps, g)
+}
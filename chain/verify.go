@@ -1,8 +1,6 @@
 package chain
 
 import (
-	"crypto/sha256"
-
 	"github.com/drand/drand/common/scheme"
 	"github.com/drand/drand/key"
 	"github.com/drand/kyber"
@@ -21,13 +19,7 @@ func NewVerifier(sch scheme.Scheme) *Verifier {
 // DigestMessage returns a slice of bytes as the message to sign or to verify
 // alongside a beacon signature.
 func (v Verifier) DigestMessage(currRound uint64, prevSig []byte) []byte {
-	h := sha256.New()
-
-	if !v.scheme.DecouplePrevSig {
-		_, _ = h.Write(prevSig)
-	}
-	_, _ = h.Write(RoundToBytes(currRound))
-	return h.Sum(nil)
+	return key.CryptoFor(v.scheme).Digest(currRound, prevSig)
 }
 
 // VerifyBeacon returns an error if the given beacon does not verify given the
@@ -40,9 +32,11 @@ func (v Verifier) VerifyBeacon(b Beacon, pubkey kyber.Point) error {
 
 	msg := v.DigestMessage(round, prevSig)
 
-	return key.Scheme.VerifyRecovered(pubkey, msg, b.Signature)
+	return key.CryptoFor(v.scheme).ThresholdScheme.VerifyRecovered(pubkey, msg, b.Signature)
 }
 
+// IsPrevSigMeaningful tells whether the beacons of the scheme sign the previous
+// signature, and must link to the previous beacon
 func (v Verifier) IsPrevSigMeaningful() bool {
-	return !v.scheme.DecouplePrevSig
+	return key.CryptoFor(v.scheme).Chained
 }
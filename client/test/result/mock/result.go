@@ -75,16 +75,11 @@ func sha256Hash(in []byte) []byte {
 	return h.Sum(nil)
 }
 
-func roundToBytes(r int) []byte {
-	var buff bytes.Buffer
-	binary.Write(&buff, binary.BigEndian, uint64(r))
-	return buff.Bytes()
-}
-
 // VerifiableResults creates a set of results that will pass a `chain.Verify` check.
 func VerifiableResults(count int, sch scheme.Scheme) (*chain.Info, []Result) {
-	secret := key.KeyGroup.Scalar().Pick(random.New())
-	public := key.KeyGroup.Point().Mul(secret, nil)
+	c := key.CryptoFor(sch)
+	secret := c.KeyGroup.Scalar().Pick(random.New())
+	public := c.KeyGroup.Point().Mul(secret, nil)
 	previous := make([]byte, 32)
 	if _, err := rand.Reader.Read(previous); err != nil {
 		panic(err)
@@ -93,15 +88,10 @@ func VerifiableResults(count int, sch scheme.Scheme) (*chain.Info, []Result) {
 	out := make([]Result, count)
 	for i := range out {
 
-		var msg []byte
-		if !sch.DecouplePrevSig {
-			msg = sha256Hash(append(previous[:], roundToBytes(i+1)...))
-		} else {
-			msg = sha256Hash(roundToBytes(i + 1))
-		}
+		msg := c.Digest(uint64(i+1), previous)
 
 		sshare := share.PriShare{I: 0, V: secret}
-		tsig, err := key.Scheme.Sign(&sshare, msg)
+		tsig, err := c.ThresholdScheme.Sign(&sshare, msg)
 		if err != nil {
 			panic(err)
 		}
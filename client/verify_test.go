@@ -10,9 +10,7 @@ import (
 	"github.com/drand/drand/common/scheme"
 )
 
-func mockClientWithVerifiableResults(n int) (client.Client, []mock.Result, error) {
-	sch := scheme.GetSchemeFromEnv()
-
+func mockClientWithVerifiableResults(n int, sch scheme.Scheme) (client.Client, []mock.Result, error) {
 	info, results := mock.VerifiableResults(n, sch)
 	mc := client.MockClient{Results: results, StrictRounds: true, OptionalInfo: info}
 
@@ -40,8 +38,51 @@ func TestVerifyWithOldVerifiedResult(t *testing.T) {
 	VerifyFuncTest(t, 5, 4)
 }
 
+func TestVerifyShortSig(t *testing.T) {
+	sch, _ := scheme.GetSchemeByID(scheme.ShortSigSchemeID)
+	unchained, _ := scheme.GetSchemeByID(scheme.UnchainedSchemeID)
+	testVerifyScheme(t, sch, unchained)
+}
+
+func TestVerifyRFC9380(t *testing.T) {
+	sch, _ := scheme.GetSchemeByID(scheme.RFC9380SchemeID)
+	short, _ := scheme.GetSchemeByID(scheme.ShortSigSchemeID)
+	testVerifyScheme(t, sch, short)
+}
+
+// testVerifyScheme checks that the beacons of the scheme verify, and that they
+// don't verify with the other scheme
+func testVerifyScheme(t *testing.T, sch, other scheme.Scheme) {
+	t.Helper()
+	c, results, err := mockClientWithVerifiableResults(3, sch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := c.Get(context.Background(), results[2].Round())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Signature()) != 48 {
+		t.Fatal("expected a signature on G1, got", len(res.Signature()), "bytes")
+	}
+
+	info, results := mock.VerifiableResults(3, sch)
+	info.Scheme = other
+	mc := client.MockClient{Results: results, StrictRounds: true, OptionalInfo: info}
+	c, err = client.Wrap(
+		[]client.Client{client.MockClientWithInfo(info), &mc},
+		client.WithChainInfo(info),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Get(context.Background(), results[2].Round()); err == nil {
+		t.Fatal("expected the verification to fail")
+	}
+}
+
 func VerifyFuncTest(t *testing.T, clients, upTo int) {
-	c, results, err := mockClientWithVerifiableResults(clients)
+	c, results, err := mockClientWithVerifiableResults(clients, scheme.GetSchemeFromEnv())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -81,6 +81,7 @@ func main() {
 		clientMetricsAddressFlag, clientMetricsGatewayFlag, clientMetricsIDFlag,
 		clientMetricsPushIntervalFlag, verboseFlag)
 	app.Action = Client
+	app.Commands = []*cli.Command{encryptCmd, decryptCmd}
 
 	// See https://cli.urfave.org/v2/examples/bash-completions/#enabling for how to turn on.
 	app.EnableBashCompletion = true
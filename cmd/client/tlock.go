@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/drand/drand/cmd/client/lib"
+	"github.com/drand/drand/tlock"
+)
+
+var durationFlag = &cli.DurationFlag{
+	Name:  "duration",
+	Usage: "encrypt to the round published after this duration, e.g. 30m",
+}
+
+var encryptCmd = &cli.Command{
+	Name:      "encrypt",
+	Usage:     "Encrypt a file, or the standard input, to a future round of the chain. The ciphertext is written to the standard output.",
+	ArgsUsage: "[file]",
+	Flags:     []cli.Flag{roundFlag, durationFlag},
+	Action:    encryptAction,
+}
+
+var decryptCmd = &cli.Command{
+	Name:      "decrypt",
+	Usage:     "Decrypt a file, or the standard input, once the round it is encrypted to is published. The plaintext is written to the standard output.",
+	ArgsUsage: "[file]",
+	Action:    decryptAction,
+}
+
+func encryptAction(c *cli.Context) error {
+	if c.IsSet(roundFlag.Name) == c.IsSet(durationFlag.Name) {
+		return errors.New("exactly one of --round and --duration must be given")
+	}
+	tl, closer, err := newTlock(c)
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	round := uint64(c.Int(roundFlag.Name))
+	if c.IsSet(durationFlag.Name) {
+		round = tl.RoundAt(time.Now().Add(c.Duration(durationFlag.Name)))
+	}
+	data, err := readInput(c)
+	if err != nil {
+		return err
+	}
+	ct, err := tl.Encrypt(round, data)
+	if err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write(ct)
+	return err
+}
+
+func decryptAction(c *cli.Context) error {
+	tl, closer, err := newTlock(c)
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	ct, err := readInput(c)
+	if err != nil {
+		return err
+	}
+	data, err := tl.Decrypt(c.Context, ct)
+	if errors.Is(err, tlock.ErrTooEarly) {
+		round, _ := tl.Round(ct)
+		return fmt.Errorf("%w: round %d is published at %s", err, round, tl.TimeOf(round).Format(time.RFC3339))
+	}
+	if err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write(data)
+	return err
+}
+
+func newTlock(c *cli.Context) (*tlock.Tlock, io.Closer, error) {
+	apiClient, err := lib.Create(c, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	info, err := apiClient.Info(context.Background())
+	if err != nil {
+		_ = apiClient.Close()
+		return nil, nil, err
+	}
+	tl, err := tlock.New(info, apiClient)
+	if err != nil {
+		_ = apiClient.Close()
+		return nil, nil, err
+	}
+	return tl, apiClient, nil
+}
+
+func readInput(c *cli.Context) ([]byte, error) {
+	if c.Args().Len() == 0 {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(c.Args().First())
+}
@@ -138,6 +138,70 @@ func TestDeleteBeacon(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestExportImportChain(t *testing.T) {
+	beaconID := test.GetBeaconIDFromEnv()
+	sch := scheme.GetSchemeFromEnv()
+	l := test.Logger(t)
+	ctx := context.Background()
+	tmp := path.Join(t.TempDir(), "drand")
+
+	// a group whose distributed key we know, to sign a valid chain
+	c := key.CryptoFor(sch)
+	secret := c.KeyGroup.Scalar().Pick(random.New())
+	_, group := test.BatchIdentities(3, sch, beaconID)
+	group.PublicKey = &key.DistPublic{Coefficients: []kyber.Point{c.KeyGroup.Point().Mul(secret, nil)}}
+	conf := core.NewConfig(core.WithConfigFolder(tmp))
+	require.NoError(t, key.NewFileStore(conf.ConfigFolderMB(), beaconID).SaveGroup(group))
+
+	info := chain.NewChainInfo(group)
+	verifier := info.Verifier()
+	fs.CreateSecureFolder(conf.DBFolder(beaconID))
+	store, err := boltdb.NewBoltStore(l, conf.DBFolder(beaconID), conf.BoltOptions())
+	require.NoError(t, err)
+	prev := chain.GenesisBeacon(info)
+	require.NoError(t, store.Put(ctx, prev))
+	for i := uint64(1); i <= 10; i++ {
+		b := &chain.Beacon{Round: i}
+		if !sch.DecouplePrevSig {
+			b.PreviousSig = prev.Signature
+		}
+		// the full signature is the partial of the only share of the secret,
+		// without its index
+		tsig, err := c.ThresholdScheme.Sign(&share.PriShare{V: secret}, verifier.DigestMessage(i, b.PreviousSig))
+		require.NoError(t, err)
+		b.Signature = tsig[2:]
+		require.NoError(t, store.Put(ctx, b))
+		prev = b
+	}
+	require.NoError(t, store.Close(ctx))
+
+	out := path.Join(t.TempDir(), "chain.pb")
+	args := []string{"drand", "util", "export-chain", "--folder", tmp, "--id", beaconID,
+		"--format", "protobuf", "--to", "6", "--out", out}
+	require.NoError(t, CLI().Run(args))
+
+	// a fresh node gets the chain from the archive
+	tmp2 := path.Join(t.TempDir(), "drand")
+	args = []string{"drand", "util", "import-chain", "--folder", tmp2, out}
+	require.NoError(t, CLI().Run(args))
+
+	conf2 := core.NewConfig(core.WithConfigFolder(tmp2))
+	store, err = boltdb.NewBoltStore(l, conf2.DBFolder(beaconID), conf2.BoltOptions())
+	require.NoError(t, err)
+	last, err := store.Last(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), last.Round)
+	sLen, err := store.Len(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 7, sLen)
+	require.NoError(t, store.Close(ctx))
+
+	// an archive of another chain is refused
+	_, otherGroup := test.BatchIdentities(3, sch, beaconID)
+	require.NoError(t, key.NewFileStore(conf2.ConfigFolderMB(), beaconID).SaveGroup(otherGroup))
+	require.Error(t, CLI().Run(args))
+}
+
 func TestKeySelfSignError(t *testing.T) {
 	beaconID := test.GetBeaconIDFromEnv()
 
@@ -184,6 +248,27 @@ func TestKeyGenError(t *testing.T) {
 	require.Error(t, app.Run(args))
 }
 
+func TestKeyGenScheme(t *testing.T) {
+	beaconID := test.GetBeaconIDFromEnv()
+
+	tmp := path.Join(t.TempDir(), "drand")
+
+	args := []string{"drand", "generate-keypair", "--folder", tmp, "--id", beaconID,
+		"--scheme", scheme.ShortSigSchemeID, "127.0.0.1:8081"}
+	require.NoError(t, CLI().Run(args))
+
+	config := core.NewConfig(core.WithConfigFolder(tmp))
+	pair, err := key.NewFileStore(config.ConfigFolderMB(), beaconID).LoadKeyPair()
+	require.NoError(t, err)
+	sch, _ := scheme.GetSchemeByID(scheme.ShortSigSchemeID)
+	require.Equal(t, key.CryptoFor(sch), key.CryptoOfKey(pair.Public.Key))
+	require.NoError(t, pair.Public.ValidSignature())
+
+	args = []string{"drand", "generate-keypair", "--folder", path.Join(t.TempDir(), "drand"), "--id", beaconID,
+		"--scheme", "unknown-scheme", "127.0.0.1:8081"}
+	require.Error(t, CLI().Run(args))
+}
+
 func TestKeyGen(t *testing.T) {
 	beaconID := test.GetBeaconIDFromEnv()
 
@@ -772,6 +857,62 @@ func TestDrandReloadBeacon(t *testing.T) {
 	testStatus(t, instances[3].ctrlPort, beaconID)
 }
 
+func TestDrandPauseResume(t *testing.T) {
+	sch := scheme.GetSchemeFromEnv()
+	beaconID := test.GetBeaconIDFromEnv()
+
+	n := 4
+	instances := launchDrandInstances(t, n)
+
+	done := make(chan error, n)
+	for i, inst := range instances {
+		if i == 0 {
+			go inst.shareLeader(t, n, n, 1, beaconID, sch, done)
+			// Wait a bit after launching the leader to launch the other nodes too.
+			time.Sleep(500 * time.Millisecond)
+		} else {
+			go inst.share(t, instances[0].addr, beaconID, done)
+		}
+	}
+
+	t.Log("waiting for initial set up to settle on all nodes")
+	for i := 0; i < n; i++ {
+		err := <-done
+		require.NoError(t, err)
+	}
+
+	defer func() {
+		output = os.Stdout
+		for _, inst := range instances {
+			_ = inst.stopAll()
+		}
+	}()
+
+	t.Log("waiting for initial setup to finish")
+	time.Sleep(5 * time.Second)
+
+	ctrlPort := instances[3].ctrlPort
+	statusOutput := func() string {
+		var buff bytes.Buffer
+		output = &buff
+		defer func() { output = os.Stdout }()
+		status := []string{"drand", "util", "status", "--control", ctrlPort, "--id", beaconID}
+		require.NoError(t, CLI().Run(status))
+		return buff.String()
+	}
+
+	resume := []string{"drand", "util", "resume", "--control", ctrlPort, "--id", beaconID}
+	require.Error(t, CLI().Run(resume))
+
+	pause := []string{"drand", "util", "pause", "--control", ctrlPort, "--id", beaconID}
+	require.NoError(t, CLI().Run(pause))
+	require.Contains(t, statusOutput(), "Paused: true")
+	require.Error(t, CLI().Run(pause))
+
+	require.NoError(t, CLI().Run(resume))
+	require.Contains(t, statusOutput(), "Paused: false")
+}
+
 func TestDrandLoadNotPresentBeacon(t *testing.T) {
 	sch := scheme.GetSchemeFromEnv()
 	beaconID := test.GetBeaconIDFromEnv()
@@ -1018,7 +1159,9 @@ func launchDrandInstances(t *testing.T, n int) []*drandInstance {
 
 		// generate key so it loads
 		// XXX let's remove this requirement - no need for longterm keys
-		priv := key.NewTLSKeyPair(addr)
+		priv := key.NewKeyPairWithScheme(addr, scheme.GetSchemeFromEnv())
+		priv.Public.TLS = true
+		priv.SelfSign()
 		require.NoError(t, key.Save(pubPath, priv.Public, false))
 		config := core.NewConfig(core.WithConfigFolder(nodePath))
 		fileStore := key.NewFileStore(config.ConfigFolderMB(), beaconID)
@@ -1075,3 +1218,16 @@ func TestSharingWithInvalidFlagCombos(t *testing.T) {
 		"--from flag invalid with --reshare - nodes resharing should already have a secret share and group ready to use",
 	)
 }
+
+func TestRelayClientsGossip(t *testing.T) {
+	hash := []byte("deadbeef")
+
+	// gossip relays only bring a watcher, built once the chain info is known
+	clients, opts, err := relayClients([]string{"/ip4/127.0.0.1/tcp/44544/p2p/QmPeqTtWVWBZXwKjpo6Ki8zAPrTTgsDfwyy7iXxGBuNUh7"}, hash)
+	require.NoError(t, err)
+	require.Empty(t, clients)
+	require.Len(t, opts, 1)
+
+	_, _, err = relayClients([]string{"/not/a/multiaddr"}, hash)
+	require.Error(t, err)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -19,6 +20,7 @@ import (
 	"github.com/drand/drand/common/scheme"
 	"github.com/drand/drand/core"
 	"github.com/drand/drand/core/migration"
+	"github.com/drand/drand/fs"
 	"github.com/drand/drand/key"
 	"github.com/drand/drand/log"
 	"github.com/drand/drand/net"
@@ -194,6 +196,11 @@ func leadShareCmd(c *cli.Context) error {
 		return fmt.Errorf("catchup period given is invalid: %w", err)
 	}
 
+	gossipFanout := c.Int(gossipFanoutFlag.Name)
+	if gossipFanout < 0 {
+		return fmt.Errorf("gossip fanout given is invalid: %d", gossipFanout)
+	}
+
 	var sch scheme.Scheme
 	if sch, err = scheme.GetSchemeByIDWithDefault(c.String(schemeFlag.Name)); err != nil {
 		return fmt.Errorf("scheme given is invalid: %w", err)
@@ -216,7 +223,7 @@ func leadShareCmd(c *cli.Context) error {
 	// new line
 	fmt.Fprintln(output, "")
 	groupP, shareErr := ctrlClient.InitDKGLeader(nodes, args.threshold, period,
-		catchupPeriod, args.timeout, args.entropy, args.secret, offset, sch.ID, beaconID)
+		catchupPeriod, args.timeout, gossipFanout, args.entropy, args.secret, offset, sch.ID, beaconID)
 
 	if shareErr != nil {
 		return fmt.Errorf("error setting up the network: %w", shareErr)
@@ -357,10 +364,16 @@ func leadReshareCmd(c *cli.Context) error {
 			return fmt.Errorf("catchup period given is invalid: %w", err)
 		}
 	}
+	gossipFanout := -1
+	if c.IsSet(gossipFanoutFlag.Name) {
+		if gossipFanout = c.Int(gossipFanoutFlag.Name); gossipFanout < 0 {
+			return fmt.Errorf("gossip fanout given is invalid: %d", gossipFanout)
+		}
+	}
 
 	fmt.Fprintf(output, "Initiating the resharing as a leader. Beacon ID: [%s] \n", beaconID)
 	groupP, shareErr := ctrlClient.InitReshareLeader(nodes, args.threshold, args.timeout,
-		catchupPeriod, args.secret, oldPath, offset, beaconID)
+		catchupPeriod, gossipFanout, args.secret, oldPath, offset, beaconID)
 
 	if shareErr != nil {
 		return fmt.Errorf("error setting up the network: %w", shareErr)
@@ -647,16 +660,113 @@ func backupDBCmd(c *cli.Context) error {
 		return err
 	}
 
-	outDir := c.String(outFlag.Name)
+	w := output
+	outFile := c.String(outFlag.Name)
+	if outFile != "" {
+		f, err := fs.CreateSecureFile(outFile)
+		if err != nil {
+			return fmt.Errorf("could not open file for backup: %w", err)
+		}
+		defer f.Close()
+		w = f
+	}
+
 	beaconID := getBeaconID(c)
-	err = client.BackupDB(outDir, beaconID)
-	if err != nil {
+	if _, err := client.StreamBackup(c.Context, beaconID, w); err != nil {
+		if outFile != "" {
+			// don't leave a partial backup behind
+			os.Remove(outFile)
+		}
 		return fmt.Errorf("could not back up: %w", err)
 	}
 
 	return nil
 }
 
+func restoreDBCmd(c *cli.Context) error {
+	if c.NArg() < 1 {
+		return errors.New("restore requires the backup file to restore")
+	}
+	// the daemon may not run from the same working directory
+	inFile, err := filepath.Abs(c.Args().First())
+	if err != nil {
+		return err
+	}
+
+	client, err := controlClient(c)
+	if err != nil {
+		return err
+	}
+
+	beaconID := getBeaconID(c)
+	restored, err := client.RestoreDB(inFile, beaconID)
+	if err != nil {
+		return fmt.Errorf("could not restore: %w", err)
+	}
+
+	fmt.Fprintf(output, "beacon id [%s] - database restored with %d beacons, the beacon is catching up\n", beaconID, restored)
+	return nil
+}
+
+func migrateDBCmd(c *cli.Context) error {
+	client, err := controlClient(c)
+	if err != nil {
+		return err
+	}
+
+	beaconID := getBeaconID(c)
+	resp, err := client.MigrateDB(beaconID)
+	if err != nil {
+		return fmt.Errorf("could not migrate database: %w", err)
+	}
+
+	// the migration runs in the background on the daemon, follow it until
+	// it is over
+	for resp.GetRunning() {
+		fmt.Fprintf(output, "beacon id [%s] - migrating the database, at round %d\n", beaconID, resp.GetRound())
+		time.Sleep(refreshRate)
+		if resp, err = client.MigrateDBStatus(beaconID); err != nil {
+			return fmt.Errorf("could not get the database migration status: %w", err)
+		}
+	}
+
+	if resp.GetError() != "" {
+		return fmt.Errorf("could not migrate database: %s", resp.GetError())
+	}
+	fmt.Fprintf(output, "beacon id [%s] - %d beacons migrated to the binary encoding\n", beaconID, resp.GetMigrated())
+	return nil
+}
+
+func pauseCmd(c *cli.Context) error {
+	client, err := controlClient(c)
+	if err != nil {
+		return err
+	}
+
+	beaconID := getBeaconID(c)
+	if err := client.PauseBeacon(beaconID); err != nil {
+		return fmt.Errorf("could not pause beacon: %w", err)
+	}
+
+	fmt.Fprintf(output, "beacon id [%s] - paused, the node no longer sends its partial signatures\n", beaconID)
+	return nil
+}
+
+func resumeCmd(c *cli.Context) error {
+	client, err := controlClient(c)
+	if err != nil {
+		return err
+	}
+
+	beaconID := getBeaconID(c)
+	if err := client.ResumeBeacon(beaconID); err != nil {
+		return fmt.Errorf("could not resume beacon: %w", err)
+	}
+
+	fmt.Fprintf(output, "beacon id [%s] - resumed, the node catches up and sends its partial signatures again\n", beaconID)
+	return nil
+}
+
 func controlPort(c *cli.Context) string {
 	port := c.String(controlFlag.Name)
 	if port == "" {
@@ -737,6 +847,9 @@ func checkCmd(c *cli.Context) error {
 		return fmt.Errorf("unable to create control client: %w", err)
 	}
 
+	if !c.IsSet(syncNodeFlag.Name) {
+		return fmt.Errorf("--%s is required to check the chain", syncNodeFlag.Name)
+	}
 	addrs := strings.Split(c.String(syncNodeFlag.Name), ",")
 
 	channel, errCh, err := ctrlClient.StartCheckChain(
@@ -856,8 +969,21 @@ func followSync(c *cli.Context) error {
 		return fmt.Errorf("unable to create control client: %w", err)
 	}
 
-	addrs := strings.Split(c.String(syncNodeFlag.Name), ",")
-	channel, errCh, err := ctrlClient.StartFollowChain(
+	startFollow := ctrlClient.StartFollowChain
+	var addrs []string
+	switch {
+	case c.IsSet(syncNodeFlag.Name) && c.IsSet(relaysFlag.Name):
+		return fmt.Errorf("--%s and --%s can't be used together", syncNodeFlag.Name, relaysFlag.Name)
+	case c.IsSet(syncNodeFlag.Name):
+		addrs = strings.Split(c.String(syncNodeFlag.Name), ",")
+	case c.IsSet(relaysFlag.Name):
+		addrs = strings.Split(c.String(relaysFlag.Name), ",")
+		startFollow = ctrlClient.StartFollowChainFromRelays
+	default:
+		return fmt.Errorf("--%s or --%s is required to follow the chain", syncNodeFlag.Name, relaysFlag.Name)
+	}
+
+	channel, errCh, err := startFollow(
 		c.Context,
 		c.String(hashInfoReq.Name),
 		addrs,
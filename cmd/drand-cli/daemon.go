@@ -5,12 +5,32 @@ import (
 
 	"github.com/urfave/cli/v2"
 
+	"github.com/drand/drand/chain"
 	"github.com/drand/drand/core"
 )
 
 func startCmd(c *cli.Context) error {
 	conf := contextToConfig(c)
 
+	engines, err := beaconStorageTypes(c)
+	if err != nil {
+		return err
+	}
+	if err := checkStorageEngine(conf, conf.DBStorageEngine()); err != nil {
+		return err
+	}
+	for beaconID, engine := range engines {
+		if err := checkStorageEngine(conf, engine); err != nil {
+			return fmt.Errorf("beacon id [%s]: %w", beaconID, err)
+		}
+	}
+	if conf.Retention().MaxAge < 0 {
+		return fmt.Errorf("invalid retention age %s, it must be positive", conf.Retention().MaxAge)
+	}
+	if conf.AuditInterval() < 0 {
+		return fmt.Errorf("invalid audit interval %s, it must be positive", conf.AuditInterval())
+	}
+
 	// Create and start drand daemon
 	drandDaemon, err := core.NewDrandDaemon(conf)
 	if err != nil {
@@ -32,6 +52,19 @@ func startCmd(c *cli.Context) error {
 	return nil
 }
 
+func checkStorageEngine(conf *core.Config, engine chain.StorageType) error {
+	switch engine {
+	case chain.BoltDB, chain.Badger, chain.PostgreSQL:
+	case chain.MemDB:
+		if conf.MemDBSize() < 1 {
+			return fmt.Errorf("invalid in-memory store size %d, it must hold at least one beacon", conf.MemDBSize())
+		}
+	default:
+		return fmt.Errorf("unsupported database storage engine %q", engine)
+	}
+	return nil
+}
+
 func stopDaemon(c *cli.Context) error {
 	ctrlClient, err := controlClient(c)
 	if err != nil {
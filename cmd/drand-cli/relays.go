@@ -0,0 +1,86 @@
+package drand
+
+import (
+	"crypto/rand"
+	"fmt"
+	nhttp "net/http"
+	"strings"
+
+	"github.com/ipfs/go-datastore"
+	dssync "github.com/ipfs/go-datastore/sync"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/host"
+	ma "github.com/multiformats/go-multiaddr"
+
+	"github.com/drand/drand/chain"
+	"github.com/drand/drand/client"
+	"github.com/drand/drand/client/http"
+	"github.com/drand/drand/log"
+	"github.com/drand/drand/lp2p"
+	gclient "github.com/drand/drand/lp2p/client"
+)
+
+// relayClients builds the clients of the http relays, given by their URL, and
+// of the gossip relays, given by their multiaddress, the daemon follows a
+// chain from. The gossip relays only notify the new beacons: the chain info
+// and the past beacons come from the other relays.
+func relayClients(relays []string, chainHash []byte) ([]client.Client, []client.Option, error) {
+	var clients []client.Client
+	var peers []string
+	for _, addr := range relays {
+		if strings.HasPrefix(addr, "/") {
+			peers = append(peers, addr)
+			continue
+		}
+		c, err := http.New(addr, chainHash, nhttp.DefaultTransport)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to reach relay %s: %w", addr, err)
+		}
+		clients = append(clients, c)
+	}
+	if len(peers) == 0 {
+		return clients, nil, nil
+	}
+
+	addrs, err := lp2p.ParseMultiaddrSlice(peers)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid gossip relay: %w", err)
+	}
+	return clients, []client.Option{client.WithWatcher(newGossipWatcher(addrs))}, nil
+}
+
+// newGossipWatcher returns a watcher of the beacons gossiped by the given
+// relays, with its own libp2p host living as long as the watcher
+func newGossipWatcher(relays []ma.Multiaddr) client.WatcherCtor {
+	return func(info *chain.Info, cache client.Cache) (client.Watcher, error) {
+		priv, _, err := crypto.GenerateEd25519Key(rand.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("generating gossip key: %w", err)
+		}
+		ds := dssync.MutexWrap(datastore.NewMapDatastore())
+		h, ps, err := lp2p.ConstructHost(ds, priv, "", relays, log.DefaultLogger())
+		if err != nil {
+			return nil, err
+		}
+		c, err := gclient.NewWithPubsub(ps, info, cache)
+		if err != nil {
+			h.Close()
+			return nil, err
+		}
+		return &gossipWatcher{Client: c, host: h}, nil
+	}
+}
+
+type gossipWatcher struct {
+	*gclient.Client
+	host host.Host
+}
+
+// Close stops watching the relays and closes the libp2p host
+func (g *gossipWatcher) Close() error {
+	err := g.Client.Close()
+	if hErr := g.host.Close(); err == nil {
+		err = hErr
+	}
+	return err
+}
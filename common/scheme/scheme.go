@@ -3,6 +3,8 @@ package scheme
 import (
 	"fmt"
 	"os"
+
+	"github.com/drand/drand/internal/schemes"
 )
 
 // DefaultSchemeID is the default scheme ID.
@@ -11,24 +13,51 @@ const DefaultSchemeID = "pedersen-bls-chained"
 // UnchainedSchemeID is the scheme id used to set unchained randomness on beacons.
 const UnchainedSchemeID = "pedersen-bls-unchained"
 
-// Scheme is used to group a set of configurations related to the scheme beacons will use to generate randomness
+// ShortSigSchemeID is the scheme id used to set unchained randomness on beacons
+// with signatures on G1, half the size of the signatures on G2, and public keys
+// on G2.
+const ShortSigSchemeID = "bls-unchained-on-g1"
+
+// RFC9380SchemeID is the scheme id used to set unchained randomness on beacons
+// with signatures on G1, hashing the messages to G1 as specified by RFC 9380
+// with the DST DSTG1.
+const RFC9380SchemeID = "bls-unchained-g1-rfc9380"
+
+// DSTG1 is the domain separation tag of the ciphersuite of RFC 9380 hashing to
+// G1 with SHA-256, used by the BLS signatures on G1 of the IETF BLS signature
+// draft. Verifiers in other languages must hash the messages of the schemes
+// using it with this exact tag.
+const DSTG1 = "BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_NUL_"
+
+// Scheme is used to group a set of configurations related to the scheme beacons will use to generate randomness.
+// New schemes are registered along with the groups and signature schemes they
+// use by key.RegisterScheme.
 type Scheme struct {
 	ID              string
 	DecouplePrevSig bool
 }
 
-var schemes = []Scheme{{ID: DefaultSchemeID, DecouplePrevSig: false}, {ID: UnchainedSchemeID, DecouplePrevSig: true}}
+func init() {
+	for _, sch := range []Scheme{
+		{ID: DefaultSchemeID, DecouplePrevSig: false},
+		{ID: UnchainedSchemeID, DecouplePrevSig: true},
+		{ID: ShortSigSchemeID, DecouplePrevSig: true},
+		{ID: RFC9380SchemeID, DecouplePrevSig: true},
+	} {
+		if err := schemes.Register(sch.ID, sch); err != nil {
+			panic(err)
+		}
+	}
+}
 
 // GetSchemeByID allows the user to retrieve the scheme configuration looking by its ID. It will return a boolean which indicates
 // if the scheme was found or not.
 func GetSchemeByID(id string) (scheme Scheme, found bool) {
-	for _, t := range schemes {
-		if t.ID == id {
-			return t, true
-		}
+	sch, found := schemes.Get(id)
+	if !found {
+		return Scheme{}, false
 	}
-
-	return Scheme{}, false
+	return sch.(Scheme), true
 }
 
 // GetSchemeByIDWithDefault allows the user to retrieve the scheme configuration looking by its ID. It will return a boolean which indicates
@@ -49,11 +78,7 @@ func GetSchemeByIDWithDefault(id string) (scheme Scheme, err error) {
 
 // ListSchemes will return a slice of valid scheme ids
 func ListSchemes() (schemeIDs []string) {
-	for _, t := range schemes {
-		schemeIDs = append(schemeIDs, t.ID)
-	}
-
-	return schemeIDs
+	return schemes.IDs()
 }
 
 // ReadSchemeByEnv allows the user to retrieve the scheme configuration looking by the ID set on an
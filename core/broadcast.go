@@ -7,7 +7,11 @@ import (
 	"fmt"
 	"math/rand"
 	"sync"
+	"time"
 
+	clock "github.com/jonboulle/clockwork"
+
+	"github.com/drand/drand/chain/beacon"
 	commonutils "github.com/drand/drand/common"
 	"github.com/drand/drand/key"
 	"github.com/drand/drand/log"
@@ -69,13 +73,16 @@ var _ Broadcast = (*echoBroadcast)(nil)
 // Packet, namely that the signature is correct.
 type verifier func(packet) error
 
+// newEchoBroadcast returns a board sending the packets to the nodes. A packet a
+// node doesn't accept is sent again until retryFor passed on the clock.
 func newEchoBroadcast(l log.Logger, version commonutils.Version, beaconID string,
-	c net.ProtocolClient, own string, to []*key.Node, v verifier) *echoBroadcast {
+	c net.ProtocolClient, own string, to []*key.Node, rep *beacon.Reputation,
+	cl clock.Clock, retryFor time.Duration, v verifier) *echoBroadcast {
 	return &echoBroadcast{
 		l:          l.Named("echoBroadcast"),
 		version:    version,
 		beaconID:   beaconID,
-		dispatcher: newDispatcher(l, c, to, own),
+		dispatcher: newDispatcher(l, c, to, own, rep, cl, retryFor),
 		dealCh:     make(chan dkg.DealBundle, len(to)),
 		respCh:     make(chan dkg.ResponseBundle, len(to)),
 		justCh:     make(chan dkg.JustificationBundle, len(to)),
@@ -130,6 +137,9 @@ func (b *echoBroadcast) BroadcastDKG(c context.Context, p *drand.DKGPacket) erro
 		return nil
 	}
 	if err := b.verif(dkgPacket); err != nil {
+		// the sender of a packet is only known by its remote address, which
+		// isn't the address of a node, and the index of an invalid packet can't
+		// be trusted, so the invalid packets aren't held against any node
 		b.l.Errorw("received invalid signature", "from", addr, "signature", dkgPacket.Sig(), "err", err)
 		return errors.New("invalid DKGPacket")
 	}
@@ -252,14 +262,15 @@ type dispatcher struct {
 	senders []*sender
 }
 
-func newDispatcher(l log.Logger, client net.ProtocolClient, to []*key.Node, us string) *dispatcher {
+func newDispatcher(l log.Logger, client net.ProtocolClient, to []*key.Node, us string, rep *beacon.Reputation,
+	cl clock.Clock, retryFor time.Duration) *dispatcher {
 	var senders = make([]*sender, 0, len(to)-1)
 	queue := senderQueueSize(len(to))
 	for _, node := range to {
 		if node.Address() == us {
 			continue
 		}
-		sender := newSender(l, client, node, queue)
+		sender := newSender(l, client, node, queue, rep, cl, retryFor)
 		go sender.run()
 		senders = append(senders, sender)
 	}
@@ -290,23 +301,44 @@ func (d *dispatcher) stop() {
 	}
 }
 
+// senderRetryPeriod is how long a sender waits before sending again the
+// packets its destination didn't accept, e.g. because the node is restarting
+const senderRetryPeriod = 2 * time.Second
+
 type sender struct {
-	l      log.Logger
-	client net.ProtocolClient
-	to     net.Peer
-	newCh  chan broadcastPacket
+	sync.Mutex
+	l          log.Logger
+	client     net.ProtocolClient
+	to         net.Peer
+	newCh      chan broadcastPacket
+	done       chan struct{}
+	stopped    bool
+	reputation *beacon.Reputation
+	clock      clock.Clock
+	// how long a packet is sent again, at most
+	retryFor time.Duration
 }
 
-func newSender(l log.Logger, client net.ProtocolClient, to net.Peer, queueSize int) *sender {
+func newSender(l log.Logger, client net.ProtocolClient, to net.Peer, queueSize int, rep *beacon.Reputation,
+	cl clock.Clock, retryFor time.Duration) *sender {
 	return &sender{
-		l:      l.Named("Sender"),
-		client: client,
-		to:     to,
-		newCh:  make(chan broadcastPacket, queueSize),
+		l:          l.Named("Sender"),
+		client:     client,
+		to:         to,
+		newCh:      make(chan broadcastPacket, queueSize),
+		done:       make(chan struct{}),
+		reputation: rep,
+		clock:      cl,
+		retryFor:   retryFor,
 	}
 }
 
 func (s *sender) sendPacket(p broadcastPacket) {
+	s.Lock()
+	defer s.Unlock()
+	if s.stopped {
+		return
+	}
 	select {
 	case s.newCh <- p:
 	default:
@@ -314,21 +346,93 @@ func (s *sender) sendPacket(p broadcastPacket) {
 	}
 }
 
+// pendingPacket is a packet the destination didn't accept yet
+type pendingPacket struct {
+	packet   broadcastPacket
+	deadline time.Time
+}
+
+// run sends the packets of the queue. A packet the destination doesn't accept
+// is sent again every senderRetryPeriod, for retryFor at most, so that a node
+// restarting during the DKG gets the packets it missed once it resumed it.
+// The packets waiting to be sent again don't hold back the next ones. Once the
+// sender is stopped, the packets left in the queue are still sent, only once.
 func (s *sender) run() {
-	for newPacket := range s.newCh {
-		s.sendDirect(newPacket)
+	var pending []pendingPacket
+	var retry <-chan time.Time
+	done := s.done
+	for {
+		select {
+		case newPacket, ok := <-s.newCh:
+			if !ok {
+				return
+			}
+			if s.send(newPacket, true) || done == nil {
+				continue
+			}
+			pending = append(pending, pendingPacket{packet: newPacket, deadline: s.clock.Now().Add(s.retryFor)})
+		case <-retry:
+			retry = nil
+			pending = s.retry(pending)
+		case <-done:
+			done, pending, retry = nil, nil, nil
+		}
+		if retry == nil && len(pending) > 0 {
+			retry = s.clock.After(senderRetryPeriod)
+		}
 	}
 }
 
+// retry sends again the pending packets and returns the ones still not
+// accepted, without the ones sent for long enough already
+func (s *sender) retry(pending []pendingPacket) []pendingPacket {
+	now := s.clock.Now()
+	left := pending[:0]
+	for _, p := range pending {
+		if s.send(p.packet, false) {
+			continue
+		}
+		if !now.Before(p.deadline) {
+			s.l.Errorw("giving up sending packet", "to", s.to.Address())
+			continue
+		}
+		left = append(left, p)
+	}
+	return left
+}
+
+// sendDirect sends the packet right away, and queues it to be sent again if
+// the destination doesn't accept it.
 func (s *sender) sendDirect(newPacket broadcastPacket) {
+	if !s.send(newPacket, true) {
+		s.sendPacket(newPacket)
+	}
+}
+
+// send sends the packet and returns whether the destination accepted it. The
+// destination is penalized for a timeout only if penalize is true, so that
+// the retries don't count against it.
+func (s *sender) send(newPacket broadcastPacket, penalize bool) bool {
 	err := s.client.BroadcastDKG(context.Background(), s.to, newPacket)
 	if err != nil {
 		s.l.Errorw("error while sending out", "to", s.to.Address(), "err:", err)
-	} else {
-		s.l.Debugw("sending out", "to", s.to.Address())
+		if penalize && beacon.IsTimeout(err) {
+			s.reputation.Penalize(s.to.Address(), beacon.OffenseTimeout)
+		}
+		return false
 	}
+	s.l.Debugw("sending out", "to", s.to.Address())
+	s.reputation.Reward(s.to.Address())
+	return true
 }
 
 func (s *sender) stop() {
+	s.Lock()
+	defer s.Unlock()
+	if s.stopped {
+		return
+	}
+	s.stopped = true
+	close(s.done)
 	close(s.newCh)
 }
@@ -2,14 +2,18 @@ package core
 
 import (
 	"context"
+	"errors"
+	"sync"
 	"testing"
 	"time"
 
+	clock "github.com/jonboulle/clockwork"
 	"github.com/stretchr/testify/require"
 
 	"github.com/drand/drand/common"
 	"github.com/drand/drand/common/scheme"
 	"github.com/drand/drand/key"
+	"github.com/drand/drand/net"
 	"github.com/drand/drand/protobuf/drand"
 	"github.com/drand/drand/test"
 	"github.com/drand/kyber"
@@ -81,7 +85,7 @@ func TestBroadcast(t *testing.T) {
 		id := d.priv.Public.Address()
 		version := common.GetAppVersion()
 		b := newEchoBroadcast(d.log, version, beaconID, d.privGateway.ProtocolClient,
-			id, group.Nodes, func(dkg.Packet) error { return nil })
+			id, group.Nodes, nil, clock.NewRealClock(), DefaultDKGTimeout, func(dkg.Packet) error { return nil })
 
 		d.dkgInfo = &dkgInfo{
 			board:   withCallback(id, b, callback),
@@ -189,3 +193,91 @@ func fakeDeal() *dkg.DealBundle {
 		}},
 	}
 }
+
+// refusingClient refuses one packet and passes the others on
+type refusingClient struct {
+	net.ProtocolClient
+	sync.Mutex
+	refused *drand.DKGPacket
+	tries   int
+	sent    chan *drand.DKGPacket
+}
+
+func (c *refusingClient) BroadcastDKG(_ context.Context, _ net.Peer, p *drand.DKGPacket, _ ...net.CallOption) error {
+	if p == c.refused {
+		c.Lock()
+		defer c.Unlock()
+		c.tries++
+		return errors.New("not now")
+	}
+	c.sent <- p
+	return nil
+}
+
+func (c *refusingClient) triesSoFar() int {
+	c.Lock()
+	defer c.Unlock()
+	return c.tries
+}
+
+func TestSenderRetry(t *testing.T) {
+	refused, accepted := &drand.DKGPacket{}, &drand.DKGPacket{}
+	client := &refusingClient{refused: refused, sent: make(chan *drand.DKGPacket, 1)}
+	c := clock.NewFakeClock()
+	s := newSender(test.Logger(t), client, net.CreatePeer("127.0.0.1:1", false), 10, nil, c, 3*senderRetryPeriod)
+	go s.run()
+	defer s.stop()
+
+	// the refused packet doesn't hold back the next one
+	s.sendPacket(refused)
+	s.sendPacket(accepted)
+	select {
+	case p := <-client.sent:
+		require.Equal(t, accepted, p)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "packet held back by the refused one")
+	}
+	require.Equal(t, 1, client.triesSoFar())
+
+	// it is sent again until it was sent for retryFor
+	for tries := 2; tries <= 4; tries++ {
+		c.BlockUntil(1)
+		c.Advance(senderRetryPeriod)
+		require.Eventually(t, func() bool { return client.triesSoFar() == tries }, 5*time.Second, 10*time.Millisecond)
+	}
+	c.Advance(senderRetryPeriod)
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, 4, client.triesSoFar())
+}
+
+func TestSenderStopFlushesQueue(t *testing.T) {
+	refused, accepted := &drand.DKGPacket{}, &drand.DKGPacket{}
+	client := &refusingClient{refused: refused, sent: make(chan *drand.DKGPacket, 1)}
+	c := clock.NewFakeClock()
+	s := newSender(test.Logger(t), client, net.CreatePeer("127.0.0.1:1", false), 10, nil, c, 3*senderRetryPeriod)
+
+	// the packets queued before the sender is stopped are still sent, e.g. the
+	// packets a node relays right before its DKG ends
+	s.sendPacket(refused)
+	s.sendPacket(accepted)
+	s.stop()
+	finished := make(chan struct{})
+	go func() {
+		s.run()
+		close(finished)
+	}()
+	select {
+	case p := <-client.sent:
+		require.Equal(t, accepted, p)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "queued packet dropped by the stop")
+	}
+
+	// but nothing is sent again
+	select {
+	case <-finished:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "sender still running after the queue is sent")
+	}
+	require.Equal(t, 1, client.triesSoFar())
+}
@@ -9,6 +9,8 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/drand/drand/chain"
+	"github.com/drand/drand/chain/beacon"
+	"github.com/drand/drand/client"
 	"github.com/drand/drand/common"
 	"github.com/drand/drand/key"
 	"github.com/drand/drand/log"
@@ -30,6 +32,16 @@ type Config struct {
 	grpcOpts          []grpc.DialOption
 	callOpts          []grpc.CallOption
 	boltOpts          *bolt.Options
+	dbStorageEngine   chain.StorageType
+	beaconDBEngines   map[string]chain.StorageType
+	pgDSN             string
+	memDBSize         int
+	retention         beacon.RetentionPolicy
+	auditInterval     time.Duration
+	storePartials     bool
+	batchVerify       bool
+	syncLimits        beacon.SyncLimits
+	relayClients      RelayClientsFunc
 	beaconCbs         []func(*chain.Beacon)
 	dkgCallback       func(*key.Share, *key.Group)
 	certPath          string
@@ -46,9 +58,16 @@ func NewConfig(opts ...ConfigOption) *Config {
 		configFolder: DefaultConfigFolder(),
 		dkgTimeout:   DefaultDKGTimeout,
 		//certmanager: net.NewCertManager(),
-		controlPort: DefaultControlPort,
-		logger:      log.DefaultLogger(),
-		clock:       clock.NewRealClock(),
+		controlPort:     DefaultControlPort,
+		logger:          log.DefaultLogger(),
+		clock:           clock.NewRealClock(),
+		dbStorageEngine: chain.BoltDB,
+		memDBSize:       DefaultMemDBSize,
+		auditInterval:   DefaultAuditInterval,
+		syncLimits: beacon.SyncLimits{
+			MaxStreams:        DefaultSyncMaxStreams,
+			MaxStreamsPerPeer: DefaultSyncMaxStreamsPerPeer,
+		},
 	}
 	for i := range opts {
 		opts[i](d)
@@ -159,6 +178,153 @@ func (d *Config) BoltOptions() *bolt.Options {
 	return d.boltOpts
 }
 
+// WithDBStorageEngine allows setting the specific storage type
+func WithDBStorageEngine(engine chain.StorageType) ConfigOption {
+	return func(d *Config) {
+		d.dbStorageEngine = engine
+	}
+}
+
+// DBStorageEngine returns the storage engine used to store random beacons
+func (d *Config) DBStorageEngine() chain.StorageType {
+	return d.dbStorageEngine
+}
+
+// WithBeaconDBStorageEngine sets the storage engine used by a single beacon,
+// overriding the one set with WithDBStorageEngine for it.
+func WithBeaconDBStorageEngine(beaconID string, engine chain.StorageType) ConfigOption {
+	return func(d *Config) {
+		if d.beaconDBEngines == nil {
+			d.beaconDBEngines = make(map[string]chain.StorageType)
+		}
+		d.beaconDBEngines[common.GetCanonicalBeaconID(beaconID)] = engine
+	}
+}
+
+// DBStorageEngineFor returns the storage engine used to store the random
+// beacons of the given beacon id
+func (d *Config) DBStorageEngineFor(beaconID string) chain.StorageType {
+	if engine, ok := d.beaconDBEngines[common.GetCanonicalBeaconID(beaconID)]; ok {
+		return engine
+	}
+	return d.dbStorageEngine
+}
+
+// WithPgDSN sets the connection string used when the storage engine is
+// PostgreSQL.
+func WithPgDSN(dsn string) ConfigOption {
+	return func(d *Config) {
+		d.pgDSN = dsn
+	}
+}
+
+// PgDSN returns the DSN used to connect to the PostgreSQL database
+func (d *Config) PgDSN() string {
+	return d.pgDSN
+}
+
+// WithMemDBSize sets how many beacons the in-memory store keeps.
+func WithMemDBSize(bufferSize int) ConfigOption {
+	return func(d *Config) {
+		d.memDBSize = bufferSize
+	}
+}
+
+// MemDBSize returns the number of beacons the in-memory store keeps
+func (d *Config) MemDBSize() int {
+	return d.memDBSize
+}
+
+// WithRetention sets how much of the chain the beacons keep in their
+// database: only the last rounds rounds and the rounds younger than maxAge are
+// kept. A zero value disables the corresponding limit.
+func WithRetention(rounds uint64, maxAge time.Duration) ConfigOption {
+	return func(d *Config) {
+		d.retention = beacon.RetentionPolicy{Rounds: rounds, MaxAge: maxAge}
+	}
+}
+
+// Retention returns the retention policy applied to the beacon databases
+func (d *Config) Retention() beacon.RetentionPolicy {
+	return d.retention
+}
+
+// WithAuditInterval sets how often the beacon databases are checked for
+// missing or invalid rounds, which are then fetched again from the group. A
+// zero interval disables the audit.
+func WithAuditInterval(interval time.Duration) ConfigOption {
+	return func(d *Config) {
+		d.auditInterval = interval
+	}
+}
+
+// AuditInterval returns how often the beacon databases are audited
+func (d *Config) AuditInterval() time.Duration {
+	return d.auditInterval
+}
+
+// WithStorePartials keeps the partial signatures recovered into each beacon
+// along with the record of the nodes that contributed to it.
+func WithStorePartials(store bool) ConfigOption {
+	return func(d *Config) {
+		d.storePartials = store
+	}
+}
+
+// StorePartials returns true if the partial signatures of each beacon are kept
+func (d *Config) StorePartials() bool {
+	return d.storePartials
+}
+
+// WithBatchVerifyPartials verifies the partial signatures received for a round
+// together when aggregating them, instead of one by one as they arrive. It
+// saves most of the pairings computed by each node, in large groups especially.
+func WithBatchVerifyPartials(batch bool) ConfigOption {
+	return func(d *Config) {
+		d.batchVerify = batch
+	}
+}
+
+// BatchVerifyPartials returns true if the partial signatures are verified in
+// batches
+func (d *Config) BatchVerifyPartials() bool {
+	return d.batchVerify
+}
+
+// WithSyncLimits bounds the sync streams the node serves to other nodes: how
+// many at the same time, how many to a single remote address, and the
+// bandwidth they share in bytes per second. A zero value disables the
+// corresponding limit.
+func WithSyncLimits(streams, streamsPerPeer int, bytesPerSecond int64) ConfigOption {
+	return func(d *Config) {
+		d.syncLimits = beacon.SyncLimits{
+			MaxStreams:        streams,
+			MaxStreamsPerPeer: streamsPerPeer,
+			BytesPerSecond:    bytesPerSecond,
+		}
+	}
+}
+
+// SyncLimits returns the limits on the sync streams served by the node
+func (d *Config) SyncLimits() beacon.SyncLimits {
+	return d.syncLimits
+}
+
+// RelayClientsFunc returns the clients, and the client options, fetching the
+// beacons of the chain with the given hash from relays that aren't drand
+// daemons: http relays given by their URL and gossip relays given by their
+// multiaddress.
+type RelayClientsFunc func(relays []string, chainHash []byte) ([]client.Client, []client.Option, error)
+
+// WithRelayClients sets how the node reaches the http and gossip relays it
+// can follow a chain from. Without it, only the public API of other drand
+// daemons can be followed.
+func WithRelayClients(fn RelayClientsFunc) ConfigOption {
+	return func(d *Config) {
+		d.relayClients = fn
+	}
+}
+
 // WithConfigFolder sets the base configuration folder to the given string.
 func WithConfigFolder(folder string) ConfigOption {
 	return func(d *Config) {
@@ -21,6 +21,35 @@ func DefaultConfigFolder() string {
 // It is relative to the DefaultConfigFolder path.
 const DefaultDBFolder = "db"
 
+// DefaultCheckChainCheckpoint is the name of the file, in the db folder, where
+// an interrupted check of the chain saves how far it went.
+const DefaultCheckChainCheckpoint = "check_chain.checkpoint"
+
+// DefaultDKGStateFile is the name of the file, in the folder of a beacon, where
+// a running DKG saves its state so that it can be resumed after a restart.
+const DefaultDKGStateFile = "dkg.state"
+
+// DefaultPauseFile is the name of the file, in the folder of a beacon, whose
+// presence records that the node is paused, so that it stays paused after a
+// restart or a resharing.
+const DefaultPauseFile = "beacon.paused"
+
+// DefaultMemDBSize is the number of beacons the in-memory store keeps when no
+// other size is given.
+const DefaultMemDBSize = 2000
+
+// DefaultAuditInterval is how often the beacon databases are checked for
+// missing or invalid rounds by default.
+const DefaultAuditInterval = 10 * time.Minute
+
+// DefaultSyncMaxStreams is the number of sync streams a node serves at the
+// same time by default.
+const DefaultSyncMaxStreams = 100
+
+// DefaultSyncMaxStreamsPerPeer is the number of sync streams a node serves at
+// the same time to a single remote address by default.
+const DefaultSyncMaxStreamsPerPeer = 8
+
 // DefaultBeaconPeriod is the period in which the beacon logic creates new
 // random beacon.
 const DefaultBeaconPeriod = 1 * time.Minute
@@ -52,8 +52,8 @@ func protoToDeal(d *pdkg.DealBundle) (*dkg.DealBundle, error) {
 	bundle.DealerIndex = d.DealerIndex
 	publics := make([]kyber.Point, 0, len(d.Commits))
 	for _, c := range d.Commits {
-		coeff := key.KeyGroup.Point()
-		if err := coeff.UnmarshalBinary(c); err != nil {
+		coeff, err := key.UnmarshalKeyPoint(c)
+		if err != nil {
 			return nil, fmt.Errorf("invalid public coeff:%w", err)
 		}
 		publics = append(publics, coeff)
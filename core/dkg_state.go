@@ -0,0 +1,536 @@
+package core
+
+import (
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	clock "github.com/jonboulle/clockwork"
+	"google.golang.org/protobuf/proto"
+
+	commonutils "github.com/drand/drand/common"
+	"github.com/drand/drand/fs"
+	"github.com/drand/drand/key"
+	"github.com/drand/drand/log"
+	pdkg "github.com/drand/drand/protobuf/crypto/dkg"
+	"github.com/drand/drand/protobuf/drand"
+	"github.com/drand/kyber/share/dkg"
+	"github.com/drand/kyber/util/random"
+	"github.com/drand/kyber/xof/blake2xb"
+)
+
+// ResumeDKG resumes the DKG or resharing the node was running when it stopped,
+// if the phase it was in is not over yet. Otherwise the DKG is abandoned.
+func (bp *BeaconProcess) ResumeDKG() error {
+	state, err := loadDKGState(bp.dkgStateFile())
+	if err != nil || state == nil {
+		return err
+	}
+
+	bp.state.Lock()
+	done := bp.group != nil && bp.group.GenesisTime == state.group.GenesisTime &&
+		bp.group.TransitionTime == state.group.TransitionTime
+	bp.state.Unlock()
+	if done {
+		// the node stopped right after the end of the DKG
+		return state.Remove()
+	}
+	now := bp.opts.clock.Now().Unix()
+	if deadline := state.deadline(); now >= deadline {
+		bp.log.Warnw("", "resume_dkg", "phase over", "deadline", deadline, "now", now)
+		return state.Remove()
+	}
+
+	bp.state.Lock()
+	if node := state.group.Find(bp.priv.Public); node != nil {
+		bp.index = int(node.Index)
+	}
+	bp.log = bp.opts.logger.Named(bp.priv.Public.Addr).Named(bp.getBeaconID()).Named(fmt.Sprint(bp.index))
+	bp.state.Unlock()
+
+	bp.log.Infow("", "resume_dkg", "start", "reshare", state.oldGroup != nil, "started", state.started())
+	go func() {
+		var err error
+		if state.oldGroup == nil {
+			_, err = bp.runDKGFrom(state)
+		} else {
+			_, err = bp.runResharingFrom(state)
+		}
+		if err != nil {
+			bp.log.Errorw("", "resume_dkg", "failed", "err", err)
+		}
+	}()
+	return nil
+}
+
+// dkgStateFile returns the path of the file the state of the DKG is saved to
+func (bp *BeaconProcess) dkgStateFile() string {
+	return path.Join(bp.opts.ConfigFolderMB(), commonutils.GetCanonicalBeaconID(bp.getBeaconID()), DefaultDKGStateFile)
+}
+
+// removeDKGState removes the state of the DKG, if any, once it can't be
+// resumed anymore
+func (bp *BeaconProcess) removeDKGState(state *dkgState) {
+	if state == nil {
+		return
+	}
+	if err := state.Remove(); err != nil {
+		bp.log.Errorw("", "dkg_state", "unable to remove", "err", err)
+	}
+}
+
+// stopDKGState stops saving the state of the running DKG, if any, before it
+// gets replaced by a new one. It requires the state lock.
+func (bp *BeaconProcess) stopDKGState() {
+	if bp.dkgInfo != nil && bp.dkgInfo.state != nil {
+		bp.dkgInfo.state.Stop()
+	}
+}
+
+// dkgSeedSize is the size of the seed the secret polynomial of a node is
+// derived from
+const dkgSeedSize = 32
+
+// maxReplayWait is how long a resumed DKG waits for the protocol to process
+// the packets of a phase before replaying the next one
+const maxReplayWait = time.Second
+
+// replayPollPeriod is how often a resumed DKG checks whether the protocol
+// processed the packets replayed
+const replayPollPeriod = 10 * time.Millisecond
+
+// dkgState is the state of a running DKG or resharing, persisted in the folder
+// of the beacon so that a node restarting in the middle of the protocol can
+// rejoin it instead of aborting it for the whole group. It records the phase
+// the node is in, our own packets and the packets received from the other
+// nodes. The secret polynomial of the node is derived from a seed kept in the
+// state, so that the resumed protocol holds the shares of the deal it already
+// sent out.
+type dkgState struct {
+	sync.Mutex
+	file string
+
+	leader   bool
+	oldGroup *key.Group
+	group    *key.Group
+	timeout  uint32
+	seed     []byte
+	// phase is the last phase of the phaser, started at phaseStart
+	phase      dkg.Phase
+	phaseStart int64
+	// resumed is the phase the state was in when loaded from disk
+	resumed  dkg.Phase
+	own      []*pdkg.Packet
+	received []*pdkg.Packet
+	// hashes of the packets recorded
+	hashes set
+	// stopped is true once the DKG is over or replaced by another one, the
+	// state isn't saved anymore
+	stopped bool
+}
+
+// dkgStateTOML is the TOML representation of a dkgState
+type dkgStateTOML struct {
+	Leader     bool
+	OldGroup   *key.GroupTOML `toml:",omitempty"`
+	Group      *key.GroupTOML
+	Timeout    uint32
+	Seed       string
+	Phase      uint32
+	PhaseStart int64
+	Own        []string
+	Received   []string
+}
+
+// newDKGState returns the state of a new DKG towards the group, from the old
+// group for a resharing. The seed of our polynomial is read from the given
+// reader, alone or mixed with crypto/rand.
+func newDKGState(file string, leader bool, oldGroup, group *key.Group, timeout uint32,
+	reader io.Reader, userOnly bool, now int64) *dkgState {
+	stream := random.New()
+	if reader != nil && userOnly {
+		stream = random.New(reader)
+	} else if reader != nil {
+		stream = random.New(reader, rand.Reader)
+	}
+	seed := make([]byte, dkgSeedSize)
+	random.Bytes(seed, stream)
+	return &dkgState{
+		file:       file,
+		leader:     leader,
+		oldGroup:   oldGroup,
+		group:      group,
+		timeout:    timeout,
+		seed:       seed,
+		phase:      dkg.InitPhase,
+		phaseStart: now,
+		hashes:     new(arraySet),
+	}
+}
+
+// loadDKGState loads the state saved in the file. It returns nil and no error
+// if there is no DKG to resume.
+func loadDKGState(file string) (*dkgState, error) {
+	if _, err := os.Stat(file); errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	s := &dkgState{file: file, hashes: new(arraySet)}
+	if err := key.Load(file, s); err != nil {
+		return nil, fmt.Errorf("unable to load the dkg state: %w", err)
+	}
+	s.resumed = s.phase
+	return s, nil
+}
+
+// TOML returns a TOML-encodable version of the state
+func (s *dkgState) TOML() interface{} {
+	st := &dkgStateTOML{
+		Leader:     s.leader,
+		Group:      groupTOML(s.group),
+		Timeout:    s.timeout,
+		Seed:       hex.EncodeToString(s.seed),
+		Phase:      uint32(s.phase),
+		PhaseStart: s.phaseStart,
+		Own:        packetsToStrings(s.own),
+		Received:   packetsToStrings(s.received),
+	}
+	if s.oldGroup != nil {
+		st.OldGroup = groupTOML(s.oldGroup)
+	}
+	return st
+}
+
+// FromTOML decodes the state from its TOML representation
+func (s *dkgState) FromTOML(i interface{}) error {
+	st, ok := i.(*dkgStateTOML)
+	if !ok {
+		return errors.New("dkg state: unknown toml")
+	}
+	var err error
+	s.group = new(key.Group)
+	if err = s.group.FromTOML(st.Group); err != nil {
+		return fmt.Errorf("dkg state: group: %w", err)
+	}
+	if st.OldGroup != nil {
+		s.oldGroup = new(key.Group)
+		if err = s.oldGroup.FromTOML(st.OldGroup); err != nil {
+			return fmt.Errorf("dkg state: old group: %w", err)
+		}
+	}
+	if s.seed, err = hex.DecodeString(st.Seed); err != nil {
+		return fmt.Errorf("dkg state: seed: %w", err)
+	}
+	if len(s.seed) != dkgSeedSize {
+		return errors.New("dkg state: invalid seed")
+	}
+	if s.own, err = s.stringsToPackets(st.Own); err != nil {
+		return fmt.Errorf("dkg state: own packets: %w", err)
+	}
+	if s.received, err = s.stringsToPackets(st.Received); err != nil {
+		return fmt.Errorf("dkg state: received packets: %w", err)
+	}
+	s.leader = st.Leader
+	s.timeout = st.Timeout
+	s.phase = dkg.Phase(st.Phase)
+	s.phaseStart = st.PhaseStart
+	return nil
+}
+
+// TOMLValue returns an empty TOML-compatible value of the state
+func (s *dkgState) TOMLValue() interface{} {
+	return &dkgStateTOML{}
+}
+
+// save writes the state to its file, atomically. It requires the lock.
+func (s *dkgState) save() error {
+	if s.stopped {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(s.file), 0o700); err != nil {
+		return fmt.Errorf("unable to save the dkg state: %w", err)
+	}
+	tmp := s.file + ".tmp"
+	fd, err := fs.CreateSecureFile(tmp)
+	if err != nil {
+		return fmt.Errorf("unable to save the dkg state: %w", err)
+	}
+	if err := toml.NewEncoder(fd).Encode(s.TOML()); err != nil {
+		fd.Close()
+		return fmt.Errorf("unable to save the dkg state: %w", err)
+	}
+	if err := fd.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.file)
+}
+
+// Save writes the state to its file
+func (s *dkgState) Save() error {
+	s.Lock()
+	defer s.Unlock()
+	return s.save()
+}
+
+// Stop stops saving the state, once another DKG replaced it
+func (s *dkgState) Stop() {
+	s.Lock()
+	defer s.Unlock()
+	s.stopped = true
+}
+
+// Remove deletes the file of the state, once the DKG is over
+func (s *dkgState) Remove() error {
+	s.Lock()
+	defer s.Unlock()
+	if s.stopped {
+		return nil
+	}
+	s.stopped = true
+	if err := os.Remove(s.file); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// deadline returns the time the phase the state is in ends at
+func (s *dkgState) deadline() int64 {
+	s.Lock()
+	defer s.Unlock()
+	return s.phaseStart + int64(phaseDuration(s.timeout)/time.Second)
+}
+
+// started returns true if the phaser of the DKG was started
+func (s *dkgState) started() bool {
+	s.Lock()
+	defer s.Unlock()
+	return s.phase > dkg.InitPhase
+}
+
+// dealt returns whether a deal of the dealer was received. The packets are
+// only recorded once the board verified their signature, so the deal was
+// signed by the key of the dealer.
+func (s *dkgState) dealt(index uint32) bool {
+	s.Lock()
+	defer s.Unlock()
+	for _, p := range s.received {
+		packet, err := protoToDKGPacket(p)
+		if err != nil {
+			continue
+		}
+		if deal, ok := packet.(*dkg.DealBundle); ok && deal.DealerIndex == index {
+			return true
+		}
+	}
+	return false
+}
+
+// suite returns the suite the DKG draws the coefficients of our secret
+// polynomial from
+func (s *dkgState) suite(suite dkg.Suite) dkg.Suite {
+	return &seededSuite{Suite: suite, seed: append([]byte("polynomial"), s.seed...)}
+}
+
+// reader returns the entropy our secret of a fresh DKG is picked from
+func (s *dkgState) reader() io.Reader {
+	return blake2xb.New(append([]byte("secret"), s.seed...))
+}
+
+// pushOwn records our own packet, and returns it. If the state already has our
+// packet of that kind, i.e. the node was restarted after sending it, it
+// returns that one instead, so that we don't send conflicting packets.
+func (s *dkgState) pushOwn(p dkg.Packet) dkg.Packet {
+	s.Lock()
+	defer s.Unlock()
+	for _, own := range s.own {
+		prev, err := protoToDKGPacket(own)
+		if err == nil && reflect.TypeOf(prev) == reflect.TypeOf(p) {
+			return prev
+		}
+	}
+	pp, err := dkgPacketToProto(p)
+	if err != nil {
+		return p
+	}
+	s.own = append(s.own, pp)
+	s.hashes.put(p.Hash())
+	_ = s.save()
+	return p
+}
+
+// receive records the packet received from another node
+func (s *dkgState) receive(p *pdkg.Packet) error {
+	packet, err := protoToDKGPacket(p)
+	if err != nil {
+		return err
+	}
+	s.Lock()
+	defer s.Unlock()
+	h := packet.Hash()
+	if s.hashes.exists(h) {
+		return nil
+	}
+	s.hashes.put(h)
+	s.received = append(s.received, p)
+	return s.save()
+}
+
+// enterPhase records that the phaser moved to the phase. It returns how long
+// the phase lasts: the phases a resumed DKG already went through are replayed
+// right away and the one it was in only lasts until its original end.
+func (s *dkgState) enterPhase(phase dkg.Phase, now int64) time.Duration {
+	s.Lock()
+	defer s.Unlock()
+	duration := phaseDuration(s.timeout)
+	switch {
+	case phase < s.resumed:
+		return 0
+	case phase == s.resumed:
+		return time.Duration(s.phaseStart+int64(duration/time.Second)-now) * time.Second
+	}
+	s.phase = phase
+	s.phaseStart = now
+	_ = s.save()
+	return duration
+}
+
+// phaser returns the phaser of the DKG of the state, waiting between the
+// phases replayed until the protocol processed the packets of the board
+func (s *dkgState) phaser(c clock.Clock, board Broadcast, l log.Logger) *dkg.TimePhaser {
+	return dkg.NewTimePhaserFunc(func(phase dkg.Phase) {
+		d := s.enterPhase(phase, c.Now().Unix())
+		if d <= 0 {
+			waitProcessed(c, board)
+		} else {
+			c.Sleep(d)
+		}
+		l.Debugw("phaser timeout", "phaser_finished", phase)
+	})
+}
+
+// replay passes the packets received before the restart to the board
+func (s *dkgState) replay(board Broadcast) {
+	s.Lock()
+	received := make([]*pdkg.Packet, len(s.received))
+	copy(received, s.received)
+	s.Unlock()
+	for _, p := range received {
+		_ = board.BroadcastDKG(context.Background(), &drand.DKGPacket{Dkg: p})
+	}
+}
+
+func (s *dkgState) stringsToPackets(strs []string) ([]*pdkg.Packet, error) {
+	packets := make([]*pdkg.Packet, 0, len(strs))
+	for _, str := range strs {
+		buff, err := hex.DecodeString(str)
+		if err != nil {
+			return nil, err
+		}
+		p := new(pdkg.Packet)
+		if err := proto.Unmarshal(buff, p); err != nil {
+			return nil, err
+		}
+		packet, err := protoToDKGPacket(p)
+		if err != nil {
+			return nil, err
+		}
+		s.hashes.put(packet.Hash())
+		packets = append(packets, p)
+	}
+	return packets, nil
+}
+
+func packetsToStrings(packets []*pdkg.Packet) []string {
+	strs := make([]string, 0, len(packets))
+	for _, p := range packets {
+		buff, err := proto.Marshal(p)
+		if err != nil {
+			continue
+		}
+		strs = append(strs, hex.EncodeToString(buff))
+	}
+	return strs
+}
+
+// groupTOML returns the TOML of the group without computing its genesis seed,
+// which for a fresh DKG is only known once the distributed key is
+func groupTOML(g *key.Group) *key.GroupTOML {
+	c := *g
+	gt := c.TOML().(*key.GroupTOML)
+	if g.GenesisSeed == nil {
+		gt.GenesisSeed = ""
+	}
+	return gt
+}
+
+// phaseDuration returns the duration of the phases of a DKG with the timeout
+func phaseDuration(timeout uint32) time.Duration {
+	if timeout == 0 {
+		return DefaultDKGTimeout
+	}
+	return time.Duration(timeout) * time.Second
+}
+
+// waitProcessed waits, for a bounded time on the clock of the DKG, until the
+// protocol processed the packets the board holds
+func waitProcessed(c clock.Clock, board Broadcast) {
+	for deadline := c.Now().Add(maxReplayWait); c.Now().Before(deadline); {
+		if len(board.IncomingDeal()) == 0 && len(board.IncomingResponse()) == 0 &&
+			len(board.IncomingJustification()) == 0 {
+			return
+		}
+		<-c.After(replayPollPeriod)
+	}
+}
+
+// seededSuite draws its random streams from a seed, so that the secret
+// polynomial of a node is the same when the DKG is resumed
+type seededSuite struct {
+	dkg.Suite
+	seed []byte
+}
+
+func (s *seededSuite) RandomStream() cipher.Stream {
+	return blake2xb.New(s.seed)
+}
+
+// stateBoard records the packets of the DKG in its state: our own packets
+// before they are pushed and the ones of the other nodes once the board
+// accepted them
+type stateBoard struct {
+	Broadcast
+	state *dkgState
+}
+
+func newStateBoard(b Broadcast, s *dkgState) *stateBoard {
+	return &stateBoard{Broadcast: b, state: s}
+}
+
+func (b *stateBoard) PushDeals(bundle *dkg.DealBundle) {
+	b.Broadcast.PushDeals(b.state.pushOwn(bundle).(*dkg.DealBundle))
+}
+
+func (b *stateBoard) PushResponses(bundle *dkg.ResponseBundle) {
+	b.Broadcast.PushResponses(b.state.pushOwn(bundle).(*dkg.ResponseBundle))
+}
+
+func (b *stateBoard) PushJustifications(bundle *dkg.JustificationBundle) {
+	b.Broadcast.PushJustifications(b.state.pushOwn(bundle).(*dkg.JustificationBundle))
+}
+
+func (b *stateBoard) BroadcastDKG(c context.Context, p *drand.DKGPacket) error {
+	if err := b.Broadcast.BroadcastDKG(c, p); err != nil {
+		return err
+	}
+	return b.state.receive(p.GetDkg())
+}
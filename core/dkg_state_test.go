@@ -0,0 +1,364 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	clock "github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/common/scheme"
+	"github.com/drand/drand/key"
+	"github.com/drand/drand/protobuf/drand"
+	"github.com/drand/drand/test"
+	"github.com/drand/kyber/share/dkg"
+)
+
+func TestDKGStateResume(t *testing.T) {
+	sch, beaconID := scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv()
+	privs, group := test.BatchIdentities(4, sch, beaconID)
+	group.GenesisSeed = nil
+	group.PublicKey = nil
+	keyGroup := key.CryptoFor(group.Scheme).KeyGroup.(dkg.Suite)
+	newDealer := func(s *dkgState, i int) *dkg.DistKeyGenerator {
+		gen, err := dkg.NewDistKeyHandler(&dkg.Config{
+			Suite:          s.suite(keyGroup),
+			NewNodes:       group.DKGNodes(),
+			Longterm:       privs[i].Key,
+			Reader:         s.reader(),
+			UserReaderOnly: true,
+			FastSync:       true,
+			Threshold:      group.Threshold,
+			Nonce:          getNonce(group),
+			Auth:           key.CryptoFor(group.Scheme).DKGAuthScheme,
+			Log:            test.Logger(t),
+		})
+		require.NoError(t, err)
+		return gen
+	}
+
+	file := filepath.Join(t.TempDir(), "beacon", DefaultDKGStateFile)
+	state := newDKGState(file, true, nil, group, 10, nil, false, 100)
+	require.NoError(t, state.Save())
+
+	deals, err := newDealer(state, 0).Deals()
+	require.NoError(t, err)
+	require.Equal(t, deals, state.pushOwn(deals))
+
+	other := newDKGState(filepath.Join(t.TempDir(), DefaultDKGStateFile), false, nil, group, 10, nil, false, 100)
+	otherDeals, err := newDealer(other, 1).Deals()
+	require.NoError(t, err)
+	p, err := dkgPacketToProto(otherDeals)
+	require.NoError(t, err)
+	require.NoError(t, state.receive(p))
+	require.NoError(t, state.receive(p))
+	// only the deals received from the others count
+	require.True(t, state.dealt(1))
+	require.False(t, state.dealt(0))
+	require.False(t, state.dealt(2))
+
+	require.Equal(t, 10*time.Second, state.enterPhase(dkg.DealPhase, 100))
+	require.Equal(t, 10*time.Second, state.enterPhase(dkg.ResponsePhase, 105))
+
+	loaded, err := loadDKGState(file)
+	require.NoError(t, err)
+	require.True(t, loaded.started())
+	require.Equal(t, int64(115), loaded.deadline())
+	require.Equal(t, group.Hash(), loaded.group.Hash())
+	require.Nil(t, loaded.group.GenesisSeed)
+	require.Nil(t, loaded.oldGroup)
+	require.Len(t, loaded.received, 1)
+
+	// the resumed node deals the same polynomial and sends the deal it sent
+	// before rather than a new one
+	resumed, err := newDealer(loaded, 0).Deals()
+	require.NoError(t, err)
+	require.Len(t, resumed.Public, len(deals.Public))
+	for i := range deals.Public {
+		require.True(t, deals.Public[i].Equal(resumed.Public[i]))
+	}
+	require.NotEqual(t, deals.Hash(), resumed.Hash())
+	require.Equal(t, deals.Hash(), loaded.pushOwn(resumed).Hash())
+
+	// the phases it went through are replayed right away, the one it was in
+	// lasts until its original end
+	require.Equal(t, time.Duration(0), loaded.enterPhase(dkg.DealPhase, 110))
+	require.Equal(t, 5*time.Second, loaded.enterPhase(dkg.ResponsePhase, 110))
+	require.Equal(t, 10*time.Second, loaded.enterPhase(dkg.JustifPhase, 115))
+
+	require.NoError(t, loaded.Remove())
+	_, err = os.Stat(file)
+	require.ErrorIs(t, err, os.ErrNotExist)
+	loaded, err = loadDKGState(file)
+	require.NoError(t, err)
+	require.Nil(t, loaded)
+}
+
+func TestResumeDKG(t *testing.T) {
+	sch, beaconID := scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv()
+	_, drands, _, _, _ := BatchNewDrand(t, 4, true, sch, beaconID) //nolint:dogsled
+	defer CloseAllDrands(drands)
+	bp := drands[0]
+
+	ids := make([]*key.Identity, 0, len(drands))
+	for _, d := range drands {
+		ids = append(ids, d.priv.Public)
+	}
+	target := key.NewGroup(ids, key.MinimumT(len(ids)), bp.opts.clock.Now().Unix()+100, 30*time.Second, 0, sch, beaconID)
+
+	// the phase the node was in is over, the DKG is abandoned
+	now := bp.opts.clock.Now().Unix()
+	state := newDKGState(bp.dkgStateFile(), false, nil, target, 10, nil, false, now-30)
+	require.Equal(t, 10*time.Second, state.enterPhase(dkg.DealPhase, now-20))
+	require.NoError(t, bp.ResumeDKG())
+	_, err := os.Stat(bp.dkgStateFile())
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	// the node rejoins the phase it was in
+	state = newDKGState(bp.dkgStateFile(), false, nil, target, 10, nil, false, now-10)
+	require.Equal(t, 10*time.Second, state.enterPhase(dkg.DealPhase, now-5))
+	require.NoError(t, bp.ResumeDKG())
+	require.Eventually(t, func() bool {
+		bp.state.Lock()
+		defer bp.state.Unlock()
+		return bp.dkgInfo != nil && bp.dkgInfo.started
+	}, 5*time.Second, 10*time.Millisecond)
+	_, err = os.Stat(bp.dkgStateFile())
+	require.NoError(t, err)
+}
+
+// memNetwork delivers the DKG packets between in-memory boards, keeping the
+// packets of the nodes that are down until they are started again
+type memNetwork struct {
+	sync.Mutex
+	boards map[int]Broadcast
+	missed map[int][]*drand.DKGPacket
+}
+
+func (n *memNetwork) send(from *memBoard, p dkg.Packet) {
+	pp, _ := dkgPacketToProto(p)
+	packet := &drand.DKGPacket{Dkg: pp}
+	n.Lock()
+	defer n.Unlock()
+	if from.dead {
+		return
+	}
+	for i, b := range n.boards {
+		if i == from.index {
+			continue
+		}
+		if b == nil {
+			n.missed[i] = append(n.missed[i], packet)
+			continue
+		}
+		go func(b Broadcast) { _ = b.BroadcastDKG(context.Background(), packet) }(b)
+	}
+}
+
+// start registers the board of the node and delivers the packets it missed
+func (n *memNetwork) start(index int, b Broadcast) {
+	n.Lock()
+	defer n.Unlock()
+	n.boards[index] = b
+	for _, p := range n.missed[index] {
+		go func(p *drand.DKGPacket) { _ = b.BroadcastDKG(context.Background(), p) }(p)
+	}
+	n.missed[index] = nil
+}
+
+// crash stops delivering the packets from and to the node
+func (n *memNetwork) crash(index int, b *memBoard) {
+	n.Lock()
+	defer n.Unlock()
+	b.dead = true
+	n.boards[index] = nil
+}
+
+type memBoard struct {
+	index  int
+	net    *memNetwork
+	dead   bool
+	dealCh chan dkg.DealBundle
+	respCh chan dkg.ResponseBundle
+	justCh chan dkg.JustificationBundle
+}
+
+func newMemBoard(index int, n *memNetwork) *memBoard {
+	return &memBoard{
+		index:  index,
+		net:    n,
+		dealCh: make(chan dkg.DealBundle, 10),
+		respCh: make(chan dkg.ResponseBundle, 10),
+		justCh: make(chan dkg.JustificationBundle, 10),
+	}
+}
+
+func (b *memBoard) PushDeals(bundle *dkg.DealBundle) {
+	b.dealCh <- *bundle
+	b.net.send(b, bundle)
+}
+
+func (b *memBoard) PushResponses(bundle *dkg.ResponseBundle) {
+	b.respCh <- *bundle
+	b.net.send(b, bundle)
+}
+
+func (b *memBoard) PushJustifications(bundle *dkg.JustificationBundle) {
+	b.justCh <- *bundle
+	b.net.send(b, bundle)
+}
+
+func (b *memBoard) IncomingDeal() <-chan dkg.DealBundle {
+	return b.dealCh
+}
+
+func (b *memBoard) IncomingResponse() <-chan dkg.ResponseBundle {
+	return b.respCh
+}
+
+func (b *memBoard) IncomingJustification() <-chan dkg.JustificationBundle {
+	return b.justCh
+}
+
+func (b *memBoard) BroadcastDKG(_ context.Context, p *drand.DKGPacket) error {
+	packet, err := protoToDKGPacket(p.GetDkg())
+	if err != nil {
+		return err
+	}
+	switch pp := packet.(type) {
+	case *dkg.DealBundle:
+		b.dealCh <- *pp
+	case *dkg.ResponseBundle:
+		b.respCh <- *pp
+	case *dkg.JustificationBundle:
+		b.justCh <- *pp
+	}
+	return nil
+}
+
+func (b *memBoard) Stop() {}
+
+func TestDKGWaitProcessed(t *testing.T) {
+	c := clock.NewFakeClock()
+	board := newMemBoard(0, &memNetwork{})
+	done := make(chan struct{})
+	wait := func() {
+		go func() {
+			waitProcessed(c, board)
+			done <- struct{}{}
+		}()
+	}
+
+	// an empty board was processed already
+	wait()
+	<-done
+
+	// the wait ends once the protocol took the packets of the board
+	board.dealCh <- dkg.DealBundle{}
+	wait()
+	c.BlockUntil(1)
+	<-board.dealCh
+	c.Advance(replayPollPeriod)
+	<-done
+
+	// or when the clock of the DKG reaches the deadline
+	board.respCh <- dkg.ResponseBundle{}
+	wait()
+	for i := time.Duration(0); i < maxReplayWait; i += replayPollPeriod {
+		c.BlockUntil(1)
+		select {
+		case <-done:
+			t.Fatal("wait ended before the deadline")
+		default:
+		}
+		c.Advance(replayPollPeriod)
+	}
+	<-done
+}
+
+func TestDKGResumeProtocol(t *testing.T) {
+	sch, beaconID := scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv()
+	n := 3
+	privs, group := test.BatchIdentities(n, sch, beaconID)
+	group.GenesisSeed = nil
+	group.PublicKey = nil
+	c := clock.NewFakeClock()
+	l := test.Logger(t)
+	dir := t.TempDir()
+	network := &memNetwork{boards: make(map[int]Broadcast), missed: make(map[int][]*drand.DKGPacket)}
+	for i := 0; i < n; i++ {
+		network.boards[i] = nil
+	}
+
+	run := func(i int, state *dkgState) (*memBoard, *dkg.Protocol) {
+		sch := key.CryptoFor(group.Scheme)
+		config := &dkg.Config{
+			Suite:          state.suite(sch.KeyGroup.(dkg.Suite)),
+			NewNodes:       group.DKGNodes(),
+			Longterm:       privs[i].Key,
+			Reader:         state.reader(),
+			UserReaderOnly: true,
+			FastSync:       true,
+			Threshold:      group.Threshold,
+			Nonce:          getNonce(group),
+			Auth:           sch.DKGAuthScheme,
+			Log:            l,
+		}
+		mem := newMemBoard(i, network)
+		board := newStateBoard(mem, state)
+		phaser := state.phaser(c, board, l)
+		proto, err := dkg.NewProtocol(config, board, phaser, true)
+		require.NoError(t, err)
+		state.replay(board)
+		network.start(i, board)
+		go phaser.Start()
+		return mem, proto
+	}
+	stateFile := func(i int) string {
+		return filepath.Join(dir, fmt.Sprint(i), DefaultDKGStateFile)
+	}
+
+	// the last node sends its deal and crashes
+	last := n - 1
+	state := newDKGState(stateFile(last), false, nil, group, 10, nil, false, c.Now().Unix())
+	require.NoError(t, state.Save())
+	mem, _ := run(last, state)
+	require.Eventually(t, func() bool {
+		s, err := loadDKGState(stateFile(last))
+		return err == nil && s.started() && len(s.own) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	network.crash(last, mem)
+
+	protos := make([]*dkg.Protocol, n)
+	for i := 0; i < last; i++ {
+		s := newDKGState(stateFile(i), false, nil, group, 10, nil, false, c.Now().Unix())
+		_, protos[i] = run(i, s)
+	}
+
+	// it resumes the DKG, and gets the packets it missed
+	state, err := loadDKGState(stateFile(last))
+	require.NoError(t, err)
+	_, protos[last] = run(last, state)
+
+	var public *key.DistPublic
+	for i, proto := range protos {
+		select {
+		case res := <-proto.WaitEnd():
+			require.NoError(t, res.Error, "node %d", i)
+			require.Len(t, res.Result.QUAL, n)
+			s := key.Share(*res.Result.Key)
+			if public == nil {
+				public = s.Public()
+				continue
+			}
+			require.True(t, public.Equal(s.Public()), "node %d", i)
+		case <-time.After(10 * time.Second):
+			require.Fail(t, "dkg not finished", "node %d", i)
+		}
+	}
+}
@@ -4,13 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/drand/drand/chain"
+	"github.com/drand/drand/chain/badgerdb"
 	"github.com/drand/drand/chain/beacon"
 	"github.com/drand/drand/chain/boltdb"
+	"github.com/drand/drand/chain/memdb"
+	"github.com/drand/drand/chain/postgresdb"
 	commonutils "github.com/drand/drand/common"
 	"github.com/drand/drand/fs"
 	"github.com/drand/drand/key"
@@ -38,6 +42,21 @@ type BeaconProcess struct {
 	pubGateway  *net.PublicGateway
 
 	beacon *beacon.Handler
+	// dbStore is the database behind the current beacon handler, without any
+	// of the wrappers the handler adds on top of it.
+	dbStore chain.Store
+
+	// memDBStore is only set when the beacons are kept in memory: it must
+	// outlive the beacon handlers, which are recreated on resharing.
+	memDBStore *memdb.Store
+
+	// migration is the last database migration started from the control
+	// plane. It runs in the background, until the beacon is stopped.
+	migration *dbMigration
+
+	// reputation scores the peers on the sync, partial and DKG paths, across
+	// the successive beacon handlers
+	reputation *beacon.Reputation
 
 	// dkg private share. can be nil if dkg not finished yet.
 	share   *key.Share
@@ -96,6 +115,10 @@ func NewBeaconProcess(log dlog.Logger, store key.Store, beaconID string, opts *C
 		pubGateway:  pubGateway,
 		exitCh:      make(chan bool, 1),
 	}
+	bp.reputation = beacon.NewReputation(log, opts.clock, bp.beaconID)
+	if opts.DBStorageEngineFor(bp.beaconID) == chain.MemDB {
+		bp.memDBStore = memdb.NewStore(log, opts.memDBSize)
+	}
 	return bp, nil
 }
 
@@ -163,12 +186,15 @@ func (bp *BeaconProcess) WaitDKG() (*key.Group, error) {
 	metrics.DKGStateChange(metrics.DKGWaiting, beaconID, false)
 
 	waitCh := bp.dkgInfo.proto.WaitEnd()
+	state := bp.dkgInfo.state
 	bp.log.Infow("", "waiting_dkg_end", time.Now())
 
 	bp.state.Unlock()
 
 	res := <-waitCh
 	if res.Error != nil {
+		// the DKG can't be resumed anymore
+		bp.removeDKGState(state)
 		return nil, fmt.Errorf("drand: error from dkg: %w", res.Error)
 	}
 
@@ -188,6 +214,12 @@ func (bp *BeaconProcess) WaitDKG() (*key.Group, error) {
 
 		if !found {
 			bp.log.Infow("disqualified node during DKG", "node", node)
+			// a node of a fresh DKG that dealt is only disqualified when its
+			// signed deal didn't hold. The dealers of a resharing are the
+			// nodes of the old group, not the ones of the target group.
+			if state.oldGroup == nil && state.dealt(node.Index) {
+				bp.reputation.Penalize(node.Address(), beacon.OffenseInvalid)
+			}
 		}
 	}
 
@@ -212,6 +244,7 @@ func (bp *BeaconProcess) WaitDKG() (*key.Group, error) {
 	if err := bp.store.SaveGroup(bp.group); err != nil {
 		return nil, err
 	}
+	bp.removeDKGState(state)
 	bp.opts.applyDkgCallback(bp.share, bp.group)
 	bp.dkgInfo.board.Stop()
 	bp.dkgInfo = nil
@@ -305,13 +338,30 @@ func (bp *BeaconProcess) WaitExit() chan bool {
 	return bp.exitCh
 }
 
-func (bp *BeaconProcess) createBoltStore() (*boltdb.BoltStore, error) {
+// createDBStore opens the beacon database of this beacon id with the storage
+// engine selected in the config.
+func (bp *BeaconProcess) createDBStore(ctx context.Context) (chain.Store, error) {
 	dbName := commonutils.GetCanonicalBeaconID(bp.beaconID)
 
-	dbPath := bp.opts.DBFolder(dbName)
-	fs.CreateSecureFolder(dbPath)
-
-	return boltdb.NewBoltStore(bp.log, dbPath, bp.opts.boltOpts)
+	engine := bp.opts.DBStorageEngineFor(dbName)
+	switch engine {
+	case chain.BoltDB:
+		dbPath := bp.opts.DBFolder(dbName)
+		fs.CreateSecureFolder(dbPath)
+
+		return boltdb.NewBoltStore(bp.log, dbPath, bp.opts.boltOpts)
+	case chain.Badger:
+		dbPath := bp.opts.DBFolder(dbName)
+		fs.CreateSecureFolder(dbPath)
+
+		return badgerdb.NewBadgerStore(bp.log, dbPath)
+	case chain.PostgreSQL:
+		return postgresdb.NewPGStore(ctx, bp.log, bp.opts.pgDSN, dbName)
+	case chain.MemDB:
+		return bp.memDBStore, nil
+	default:
+		return nil, fmt.Errorf("unknown database storage engine type %q", engine)
+	}
 }
 
 func (bp *BeaconProcess) newBeacon() (*beacon.Handler, error) {
@@ -325,13 +375,20 @@ func (bp *BeaconProcess) newBeacon() (*beacon.Handler, error) {
 		return nil, fmt.Errorf("public key %s not found in group", pub)
 	}
 	conf := &beacon.Config{
-		Public: node,
-		Group:  bp.group,
-		Share:  bp.share,
-		Clock:  bp.opts.clock,
+		Public:              node,
+		Group:               bp.group,
+		Share:               bp.share,
+		Clock:               bp.opts.clock,
+		Retention:           bp.opts.Retention(),
+		AuditInterval:       bp.opts.AuditInterval(),
+		StorePartials:       bp.opts.StorePartials(),
+		BatchVerifyPartials: bp.opts.BatchVerifyPartials(),
+		Reputation:          bp.reputation,
+		CheckpointFile:      path.Join(bp.opts.DBFolder(bp.getBeaconID()), DefaultCheckChainCheckpoint),
+		PauseFile:           bp.pauseFile(),
 	}
 
-	store, err := bp.createBoltStore()
+	store, err := bp.createDBStore(context.Background())
 	if err != nil {
 		return nil, err
 	}
@@ -341,6 +398,7 @@ func (bp *BeaconProcess) newBeacon() (*beacon.Handler, error) {
 		return nil, err
 	}
 	bp.beacon = b
+	bp.dbStore = store
 	bp.beacon.AddCallback("opts", bp.opts.callbacks)
 	// cancel any sync operations
 	if bp.syncerCancel != nil {
@@ -350,6 +408,11 @@ func (bp *BeaconProcess) newBeacon() (*beacon.Handler, error) {
 	return bp.beacon, nil
 }
 
+// pauseFile returns where the node records that it is paused
+func (bp *BeaconProcess) pauseFile() string {
+	return path.Join(bp.opts.ConfigFolderMB(), commonutils.GetCanonicalBeaconID(bp.getBeaconID()), DefaultPauseFile)
+}
+
 func checkGroup(l dlog.Logger, group *key.Group) {
 	unsigned := group.UnsignedIdentities()
 	if unsigned == nil {
@@ -370,8 +433,11 @@ func (bp *BeaconProcess) StopBeacon() {
 		return
 	}
 
+	// the migration works on the store of the handler, which closes it
+	bp.migration.stop()
 	bp.beacon.Stop()
 	bp.beacon = nil
+	bp.dbStore = nil
 }
 
 func (bp *BeaconProcess) isFreshRun() bool {
@@ -415,4 +481,6 @@ type dkgInfo struct {
 	conf    *dkg.Config
 	proto   *dkg.Protocol
 	started bool
+	// state is persisted to resume the DKG after a restart
+	state *dkgState
 }
@@ -9,13 +9,19 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
+	"path"
 	"strings"
+	"sync"
 	"time"
 
 	clock "github.com/jonboulle/clockwork"
 
 	"github.com/drand/drand/chain"
 	"github.com/drand/drand/chain/beacon"
+	"github.com/drand/drand/chain/boltdb"
+	chainerrors "github.com/drand/drand/chain/errors"
+	"github.com/drand/drand/client"
 	commonutils "github.com/drand/drand/common"
 	"github.com/drand/drand/common/scheme"
 	"github.com/drand/drand/entropy"
@@ -70,6 +76,7 @@ func (bp *BeaconProcess) InitDKG(c context.Context, in *drand.InitDKGPacket) (*d
 				d.priv.Public,
 				in.GetBeaconPeriod(),
 				in.GetCatchupPeriod(),
+				in.GetGossipFanout(),
 				bp.getBeaconID(),
 				in.GetSchemeID(),
 				in.GetInfo(),
@@ -299,6 +306,311 @@ func (bp *BeaconProcess) BackupDatabase(ctx context.Context, req *drand.BackupDB
 	return &drand.BackupDBResponse{Metadata: bp.newMetadata()}, inst.Store().SaveTo(ctx, w)
 }
 
+// backupChunkSize is the size of the chunks StreamBackup sends the backup in
+const backupChunkSize = 64 << 10
+
+// StreamBackup sends a backup of the database of this beacon over the stream,
+// in chunks followed by the SHA-256 checksum of the whole backup.
+func (bp *BeaconProcess) StreamBackup(req *drand.StreamBackupRequest, stream drand.Control_StreamBackupServer) error {
+	bp.state.Lock()
+	if bp.beacon == nil {
+		bp.state.Unlock()
+		return errors.New("drand: beacon not setup yet")
+	}
+	inst := bp.beacon
+	bp.state.Unlock()
+
+	h := sha256.New()
+	w := &chunkWriter{stream: stream, buf: make([]byte, 0, backupChunkSize)}
+	if err := inst.Store().SaveTo(stream.Context(), io.MultiWriter(h, w)); err != nil {
+		return fmt.Errorf("could not back up database: %w", err)
+	}
+	if err := w.flush(); err != nil {
+		return err
+	}
+
+	bp.log.Infow("", "backup", "streamed", "size", w.size)
+	return stream.Send(&drand.BackupChunk{Checksum: h.Sum(nil), Metadata: bp.newMetadata()})
+}
+
+// chunkWriter sends what is written to it over a backup stream in chunks of at
+// most backupChunkSize bytes.
+type chunkWriter struct {
+	stream drand.Control_StreamBackupServer
+	buf    []byte
+	size   int
+}
+
+func (c *chunkWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		free := backupChunkSize - len(c.buf)
+		if free > len(p) {
+			free = len(p)
+		}
+		c.buf = append(c.buf, p[:free]...)
+		p = p[free:]
+		if len(c.buf) == backupChunkSize {
+			if err := c.flush(); err != nil {
+				return n - len(p), err
+			}
+		}
+	}
+	return n, nil
+}
+
+func (c *chunkWriter) flush() error {
+	if len(c.buf) == 0 {
+		return nil
+	}
+	if err := c.stream.Send(&drand.BackupChunk{Data: c.buf}); err != nil {
+		return err
+	}
+	c.size += len(c.buf)
+	// the chunk sent may still be referenced by the stream
+	c.buf = make([]byte, 0, backupChunkSize)
+	return nil
+}
+
+// RestoreDatabase replaces the database of this beacon with a backup made by
+// BackupDatabase. The backup is verified against the current group before the
+// beacon is stopped, then it is swapped in and the beacon restarts with a
+// catchup to fetch the rounds produced since the backup was made.
+func (bp *BeaconProcess) RestoreDatabase(ctx context.Context, req *drand.RestoreDBRequest) (*drand.RestoreDBResponse, error) {
+	bp.state.Lock()
+	group := bp.group
+	bp.state.Unlock()
+	if group == nil {
+		return nil, errors.New("drand: no dkg group setup yet")
+	}
+	if engine := bp.opts.DBStorageEngineFor(bp.getBeaconID()); engine != chain.BoltDB {
+		return nil, fmt.Errorf("drand: only bolt databases can be restored, not %s", engine)
+	}
+
+	dbPath := bp.opts.DBFolder(bp.getBeaconID())
+	fs.CreateSecureFolder(dbPath)
+
+	// the backup is staged next to the database so it can be renamed over it
+	staging, err := os.MkdirTemp(path.Dir(dbPath), "restore-")
+	if err != nil {
+		return nil, fmt.Errorf("could not create staging folder: %w", err)
+	}
+	defer os.RemoveAll(staging)
+
+	if err := fs.CopyFile(req.GetInputFile(), path.Join(staging, boltdb.BoltFileName)); err != nil {
+		return nil, fmt.Errorf("could not read backup: %w", err)
+	}
+	restored, err := bp.verifyBackup(ctx, staging, chain.NewChainInfo(group))
+	if err != nil {
+		return nil, fmt.Errorf("drand: invalid backup: %w", err)
+	}
+
+	bp.state.Lock()
+	wasRunning := bp.beacon != nil
+	bp.state.Unlock()
+
+	bp.StopBeacon()
+	err = os.Rename(path.Join(staging, boltdb.BoltFileName), path.Join(dbPath, boltdb.BoltFileName))
+	if err != nil {
+		err = fmt.Errorf("drand: could not swap database: %w", err)
+	} else {
+		bp.log.Infow("", "restore_db", "done", "beacons", restored)
+	}
+
+	// we restart on whichever database is in place now
+	if wasRunning {
+		bp.StartBeacon(true)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &drand.RestoreDBResponse{Restored: uint64(restored), Metadata: bp.newMetadata()}, nil
+}
+
+// verifyBackup checks that the bolt database in folder holds beacons of the
+// given chain with valid signatures, and returns how many beacons it holds.
+func (bp *BeaconProcess) verifyBackup(ctx context.Context, folder string, info *chain.Info) (int, error) {
+	store, err := boltdb.NewBoltStore(bp.log, folder, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer store.Close(ctx)
+
+	genesis, err := store.Get(ctx, 0)
+	if err != nil {
+		return 0, fmt.Errorf("no genesis beacon: %w", err)
+	}
+	if !genesis.Equal(chain.GenesisBeacon(info)) {
+		return 0, fmt.Errorf("the backup doesn't belong to chain %s", info.HashString())
+	}
+
+	verifier := info.Verifier()
+	count := 0
+	err = store.Cursor(ctx, func(ctx context.Context, c chain.Cursor) error {
+		prev := genesis
+		for b, err := c.Seek(ctx, 1); b != nil; b, err = c.Next(ctx) {
+			if err != nil {
+				return err
+			}
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := verifier.VerifyBeacon(*b, info.PublicKey); err != nil {
+				return fmt.Errorf("invalid beacon for round %d: %w", b.Round, err)
+			}
+			if verifier.IsPrevSigMeaningful() && prev.Round+1 == b.Round && !bytes.Equal(prev.Signature, b.PreviousSig) {
+				return fmt.Errorf("beacon for round %d doesn't follow the previous one", b.Round)
+			}
+			prev = b
+			count++
+		}
+		return nil
+	})
+
+	// we count the genesis beacon as well
+	return count + 1, err
+}
+
+// MigrateDatabase rewrites the beacons stored by older versions with the
+// current encoding. The migration runs in the background on the live
+// database: the beacon process keeps producing and serving beacons meanwhile,
+// and the caller polls its progress with the status flag of the request.
+func (bp *BeaconProcess) MigrateDatabase(_ context.Context, in *drand.MigrateDBRequest) (*drand.MigrateDBResponse, error) {
+	bp.state.Lock()
+	defer bp.state.Unlock()
+	if bp.beacon == nil {
+		return nil, errors.New("drand: beacon not setup yet")
+	}
+
+	if in.GetStatus() || bp.migration.isRunning() {
+		if bp.migration == nil {
+			return nil, errors.New("drand: no database migration started")
+		}
+		return bp.migration.response(bp.newMetadata()), nil
+	}
+
+	bs, ok := bp.dbStore.(*boltdb.BoltStore)
+	if !ok {
+		return nil, fmt.Errorf("drand: only bolt databases need to be migrated, not %s", bp.opts.DBStorageEngineFor(bp.getBeaconID()))
+	}
+
+	bp.migration = startMigration(bp.log, bs)
+	return bp.migration.response(bp.newMetadata()), nil
+}
+
+// dbMigration tracks a database migration running in the background
+type dbMigration struct {
+	sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	running  bool
+	round    uint64
+	migrated uint64
+	err      error
+}
+
+func startMigration(l log.Logger, bs *boltdb.BoltStore) *dbMigration {
+	ctx, cancel := context.WithCancel(context.Background())
+	m := &dbMigration{
+		cancel:  cancel,
+		done:    make(chan struct{}),
+		running: true,
+	}
+
+	go func() {
+		defer close(m.done)
+		defer cancel()
+
+		l.Infow("", "migrate_db", "start")
+		migrated, err := bs.Migrate(ctx, func(round uint64) {
+			m.Lock()
+			m.round = round
+			m.Unlock()
+			l.Debugw("", "migrate_db", "progress", "round", round)
+		})
+
+		m.Lock()
+		defer m.Unlock()
+		m.running = false
+		m.migrated = uint64(migrated)
+		if err != nil {
+			m.err = fmt.Errorf("database migration stopped after %d beacons: %w", migrated, err)
+			l.Errorw("", "migrate_db", "stopped", "migrated", migrated, "err", err)
+			return
+		}
+		l.Infow("", "migrate_db", "done", "migrated", migrated)
+	}()
+
+	return m
+}
+
+func (m *dbMigration) isRunning() bool {
+	if m == nil {
+		return false
+	}
+	m.Lock()
+	defer m.Unlock()
+	return m.running
+}
+
+// stop cancels the migration and waits for it to return
+func (m *dbMigration) stop() {
+	if m == nil {
+		return
+	}
+	m.cancel()
+	<-m.done
+}
+
+func (m *dbMigration) response(metadata *common.Metadata) *drand.MigrateDBResponse {
+	m.Lock()
+	defer m.Unlock()
+	resp := &drand.MigrateDBResponse{
+		Migrated: m.migrated,
+		Running:  m.running,
+		Round:    m.round,
+		Metadata: metadata,
+	}
+	if m.err != nil {
+		resp.Error = m.err.Error()
+	}
+	return resp
+}
+
+// PauseBeacon stops the node from sending its partial signatures, while it
+// keeps syncing and serving the chain. The node stays paused after a restart.
+func (bp *BeaconProcess) PauseBeacon(context.Context, *drand.PauseBeaconRequest) (*drand.PauseBeaconResponse, error) {
+	bp.state.Lock()
+	inst := bp.beacon
+	bp.state.Unlock()
+	if inst == nil {
+		return nil, errors.New("drand: beacon not setup yet")
+	}
+
+	if err := inst.Pause(); err != nil {
+		return nil, fmt.Errorf("drand: can't pause beacon: %w", err)
+	}
+	return &drand.PauseBeaconResponse{Metadata: bp.newMetadata()}, nil
+}
+
+// ResumeBeacon makes a paused node catch up with the chain and send its
+// partial signatures again.
+func (bp *BeaconProcess) ResumeBeacon(context.Context, *drand.ResumeBeaconRequest) (*drand.ResumeBeaconResponse, error) {
+	bp.state.Lock()
+	inst := bp.beacon
+	bp.state.Unlock()
+	if inst == nil {
+		return nil, errors.New("drand: beacon not setup yet")
+	}
+
+	if err := inst.Resume(); err != nil {
+		return nil, fmt.Errorf("drand: can't resume beacon: %w", err)
+	}
+	return &drand.ResumeBeaconResponse{Metadata: bp.newMetadata()}, nil
+}
+
 // ////////
 
 func (bp *BeaconProcess) leaderRunSetup(newSetup func(d *BeaconProcess) (*setupManager, error)) (group *key.Group, err error) {
@@ -361,47 +673,64 @@ func (bp *BeaconProcess) leaderRunSetup(newSetup func(d *BeaconProcess) (*setupM
 // runDKG setups the proper structures and protocol to run the DKG and waits
 // until it finishes. If leader is true, this node sends the first packet.
 func (bp *BeaconProcess) runDKG(leader bool, group *key.Group, timeout uint32, randomness *drand.EntropyInfo) (*key.Group, error) {
+	reader, user := extractEntropy(randomness)
+	state := newDKGState(bp.dkgStateFile(), leader, nil, group, timeout, reader, user, bp.opts.clock.Now().Unix())
+	if err := state.Save(); err != nil {
+		return nil, err
+	}
+	return bp.runDKGFrom(state)
+}
+
+// runDKGFrom runs the DKG of the state, which is either a new one or one
+// resumed after a restart of the node
+func (bp *BeaconProcess) runDKGFrom(state *dkgState) (*key.Group, error) {
+	group := state.group
+	leader := state.leader
 	beaconID := commonutils.GetCanonicalBeaconID(group.ID)
 
-	reader, user := extractEntropy(randomness)
+	sch := key.CryptoFor(group.Scheme)
 	config := &dkg.Config{
-		Suite:          key.KeyGroup.(dkg.Suite),
+		Suite:          state.suite(sch.KeyGroup.(dkg.Suite)),
 		NewNodes:       group.DKGNodes(),
 		Longterm:       bp.priv.Key,
-		Reader:         reader,
-		UserReaderOnly: user,
+		Reader:         state.reader(),
+		UserReaderOnly: true,
 		FastSync:       true,
 		Threshold:      group.Threshold,
 		Nonce:          getNonce(group),
-		Auth:           key.DKGAuthScheme,
+		Auth:           sch.DKGAuthScheme,
 		Log:            bp.log,
 	}
-	phaser := bp.getPhaser(timeout)
-	board := newEchoBroadcast(bp.log, bp.version, beaconID, bp.privGateway.ProtocolClient,
-		bp.priv.Public.Address(), group.Nodes, func(p dkg.Packet) error {
+	var board Broadcast = newStateBoard(newEchoBroadcast(bp.log, bp.version, beaconID, bp.privGateway.ProtocolClient,
+		bp.priv.Public.Address(), group.Nodes, bp.reputation, bp.opts.clock, phaseDuration(state.timeout), func(p dkg.Packet) error {
 			return dkg.VerifyPacketSignature(config, p)
-		})
+		}), state)
+	phaser := state.phaser(bp.opts.clock, board, bp.log)
 	dkgProto, err := dkg.NewProtocol(config, board, phaser, true)
 	if err != nil {
 		return nil, err
 	}
+	state.replay(board)
 
+	started := leader || state.started()
 	bp.state.Lock()
+	bp.stopDKGState()
 	dkgInfo := &dkgInfo{
 		target: group,
 		board:  board,
 		phaser: phaser,
 		conf:   config,
 		proto:  dkgProto,
+		state:  state,
 	}
 	bp.dkgInfo = dkgInfo
-	if leader {
+	if started {
 		bp.dkgInfo.started = true
 	}
 	metrics.DKGStateChange(metrics.DKGInProgress, beaconID, leader)
 	bp.state.Unlock()
 
-	if leader {
+	if started {
 		// phaser will kick off the first phase for every other nodes so
 		// nodes will send their deals
 		bp.log.Infow("", "init_dkg", "START_DKG")
@@ -446,20 +775,35 @@ func (bp *BeaconProcess) cleanupDKG() {
 //
 //nolint:funlen
 func (bp *BeaconProcess) runResharing(leader bool, oldGroup, newGroup *key.Group, timeout uint32) (*key.Group, error) {
+	if leader && oldGroup.Find(bp.priv.Public) == nil {
+		bp.log.Errorw("", "run_reshare", "invalid", "leader", leader, "old_present", false)
+		return nil, errors.New("can not be a leader if not present in the old group")
+	}
+
+	state := newDKGState(bp.dkgStateFile(), leader, oldGroup, newGroup, timeout, nil, false, bp.opts.clock.Now().Unix())
+	if err := state.Save(); err != nil {
+		return nil, err
+	}
+	return bp.runResharingFrom(state)
+}
+
+// runResharingFrom runs the resharing of the state, which is either a new one
+// or one resumed after a restart of the node
+//
+//nolint:funlen
+func (bp *BeaconProcess) runResharingFrom(state *dkgState) (*key.Group, error) {
+	leader := state.leader
+	oldGroup, newGroup := state.oldGroup, state.group
 	oldBeaconID := commonutils.GetCanonicalBeaconID(oldGroup.ID)
 
 	oldNode := oldGroup.Find(bp.priv.Public)
 	oldPresent := oldNode != nil
 
-	if leader && !oldPresent {
-		bp.log.Errorw("", "run_reshare", "invalid", "leader", leader, "old_present", oldPresent)
-		return nil, errors.New("can not be a leader if not present in the old group")
-	}
-
 	newNode := newGroup.Find(bp.priv.Public)
 	newPresent := newNode != nil
+	sch := key.CryptoFor(newGroup.Scheme)
 	config := &dkg.Config{
-		Suite:        key.KeyGroup.(dkg.Suite),
+		Suite:        state.suite(sch.KeyGroup.(dkg.Suite)),
 		NewNodes:     newGroup.DKGNodes(),
 		OldNodes:     oldGroup.DKGNodes(),
 		Longterm:     bp.priv.Key,
@@ -467,7 +811,7 @@ func (bp *BeaconProcess) runResharing(leader bool, oldGroup, newGroup *key.Group
 		OldThreshold: oldGroup.Threshold,
 		FastSync:     true,
 		Nonce:        getNonce(newGroup),
-		Auth:         key.DKGAuthScheme,
+		Auth:         sch.DKGAuthScheme,
 		Log:          bp.log,
 	}
 	err := func() error {
@@ -496,38 +840,44 @@ func (bp *BeaconProcess) runResharing(leader bool, oldGroup, newGroup *key.Group
 
 	allNodes := nodeUnion(oldGroup.Nodes, newGroup.Nodes)
 	var board Broadcast = newEchoBroadcast(bp.log, bp.version, oldBeaconID, bp.privGateway.ProtocolClient,
-		bp.priv.Public.Address(), allNodes, func(p dkg.Packet) error {
+		bp.priv.Public.Address(), allNodes, bp.reputation, bp.opts.clock, phaseDuration(state.timeout), func(p dkg.Packet) error {
 			return dkg.VerifyPacketSignature(config, p)
 		})
 
 	if bp.dkgBoardSetup != nil {
 		board = bp.dkgBoardSetup(board)
 	}
-	phaser := bp.getPhaser(timeout)
+	board = newStateBoard(board, state)
+	phaser := state.phaser(bp.opts.clock, board, bp.log)
 
 	dkgProto, err := dkg.NewProtocol(config, board, phaser, true)
 	if err != nil {
 		return nil, err
 	}
+	state.replay(board)
+
+	started := leader || state.started()
 	info := &dkgInfo{
 		target: newGroup,
 		board:  board,
 		phaser: phaser,
 		conf:   config,
 		proto:  dkgProto,
+		state:  state,
 	}
 	bp.state.Lock()
+	bp.stopDKGState()
 	bp.dkgInfo = info
-	if leader {
-		bp.log.Infow("", "dkg_reshare", "leader_start",
-			"target_group", hex.EncodeToString(newGroup.Hash()), "index", newNode.Index)
+	if started {
+		bp.log.Infow("", "dkg_reshare", "start", "leader", leader,
+			"target_group", hex.EncodeToString(newGroup.Hash()), "new_present", newPresent)
 		bp.dkgInfo.started = true
 	}
 
 	metrics.ReshareStateChange(metrics.ReshareInProgess, oldBeaconID, leader)
 	bp.state.Unlock()
 
-	if leader {
+	if started {
 		// start the protocol so everyone else follows
 		// it sends to all previous and new nodes. old nodes will start their
 		// phaser so they will send the deals as soon as they receive this.
@@ -838,6 +1188,33 @@ func (bp *BeaconProcess) RemoteStatus(ctx context.Context, in *drand.RemoteStatu
 	}, nil
 }
 
+// fillChainCompleteness reports how many rounds after genesis the store holds
+// and how many are missing between the lowest and highest of them.
+func fillChainCompleteness(ctx context.Context, store chain.Store, status *drand.ChainStoreStatus) error {
+	first, last, err := store.Range(ctx)
+	if errors.Is(err, chainerrors.ErrNoBeaconStored) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	length, err := store.Len(ctx)
+	if err != nil {
+		return err
+	}
+
+	stored := uint64(length)
+	if _, err := store.Get(ctx, 0); err == nil && stored > 0 {
+		stored--
+	}
+	status.FirstRound = first
+	status.Stored = stored
+	if expected := last - first + 1; expected > stored {
+		status.Missing = expected - stored
+	}
+	return nil
+}
+
 // Status responds with the actual status of drand process
 //
 //nolint:funlen,gocyclo
@@ -851,6 +1228,7 @@ func (bp *BeaconProcess) Status(ctx context.Context, in *drand.StatusRequest) (*
 	reshareStatus := drand.ReshareStatus{}
 	beaconStatus := drand.BeaconStatus{}
 	chainStore := drand.ChainStoreStatus{}
+	var partials []*drand.PeerPartialStatus
 
 	// DKG status
 	switch {
@@ -881,15 +1259,24 @@ func (bp *BeaconProcess) Status(ctx context.Context, in *drand.StatusRequest) (*
 		beaconStatus.IsStopped = bp.beacon.IsStopped()
 		beaconStatus.IsRunning = bp.beacon.IsRunning()
 		beaconStatus.IsServing = bp.beacon.IsServing()
+		beaconStatus.IsPaused = bp.beacon.IsPaused()
 
 		// Chain store
-		lastBeacon, err := bp.beacon.Store().Last(ctx)
+		store := bp.beacon.Store()
+		lastBeacon, err := store.Last(ctx)
 
 		if err == nil && lastBeacon != nil {
 			chainStore.IsEmpty = false
 			chainStore.LastRound = lastBeacon.GetRound()
 			chainStore.Length = lastBeacon.GetRound() + 1
 		}
+
+		// the stores keep track of their range and length, so this doesn't
+		// scan the database
+		if err := fillChainCompleteness(ctx, store, &chainStore); err != nil {
+			bp.log.Debugw("unable to get chain completeness", "err", err)
+		}
+		partials = bp.beacon.PartialStatus()
 	}
 
 	// remote network connectivity
@@ -938,6 +1325,8 @@ func (bp *BeaconProcess) Status(ctx context.Context, in *drand.StatusRequest) (*
 		Reshare:    &reshareStatus,
 		ChainStore: &chainStore,
 		Beacon:     &beaconStatus,
+		Partials:   partials,
+		Reputation: bp.reputation.Status(),
 	}
 	if len(resp) > 0 {
 		packet.Connections = resp
@@ -970,19 +1359,6 @@ func extractGroup(i *drand.GroupInfo) (*key.Group, error) {
 	return g, nil
 }
 
-func (bp *BeaconProcess) getPhaser(timeout uint32) *dkg.TimePhaser {
-	tDuration := time.Duration(timeout) * time.Second
-	if timeout == 0 {
-		tDuration = DefaultDKGTimeout
-	}
-	// We create a copy of the logger to avoid races when the logger changes
-	logger := bp.log
-	return dkg.NewTimePhaserFunc(func(phase dkg.Phase) {
-		bp.opts.clock.Sleep(tDuration)
-		logger.Debugw("phaser timeout", "phaser_finished", phase)
-	})
-}
-
 func nodesContainAddr(nodes []*key.Node, addr string) bool {
 	for _, n := range nodes {
 		if n.Address() == addr {
@@ -1033,7 +1409,7 @@ func (bp *BeaconProcess) pushDKGInfo(outgoing, incoming []*key.Node, previousThr
 	secret []byte, timeout uint32,
 ) error {
 	// sign the group to prove you are the leader
-	signature, err := key.DKGAuthScheme.Sign(bp.priv.Key, group.Hash())
+	signature, err := key.CryptoOfKey(bp.priv.Public.Key).DKGAuthScheme.Sign(bp.priv.Key, group.Hash())
 	if err != nil {
 		bp.log.Errorw("", "setup", "leader", "group_signature", err)
 		return fmt.Errorf("drand: error signing group: %w", err)
@@ -1153,14 +1529,27 @@ func (bp *BeaconProcess) StartFollowChain(req *drand.StartSyncRequest, stream dr
 	}
 
 	beaconID := bp.getBeaconID()
+	// we need to get the beaconID from the request since we follow a chain we might not know yet
+	hash := req.GetMetadata().GetChainHash()
 
-	info, err := chainInfoFromPeers(stream.Context(), bp.privGateway, peers, bp.log, bp.version, beaconID)
+	// following from relays doesn't need the nodes of the chain to allow us
+	var relays client.Client
+	var info *chain.Info
+	var err error
+	if len(req.GetRelays()) > 0 {
+		relays, err = relaysClient(bp.privGateway, bp.opts.relayClients, req.GetRelays(), req.GetIsTls(), hash, beaconID, bp.log)
+		if err != nil {
+			return err
+		}
+		defer relays.Close()
+		info, err = relays.Info(stream.Context())
+	} else {
+		info, err = chainInfoFromPeers(stream.Context(), bp.privGateway, peers, bp.log, bp.version, beaconID)
+	}
 	if err != nil {
 		return err
 	}
 
-	// we need to get the beaconID from the request since we follow a chain we might not know yet
-	hash := req.GetMetadata().GetChainHash()
 	if !bytes.Equal(info.Hash(), hash) {
 		return fmt.Errorf("chain hash mismatch: rcv(%x) != bp(%x)", info.Hash(), hash)
 	}
@@ -1171,7 +1560,7 @@ func (bp *BeaconProcess) StartFollowChain(req *drand.StartSyncRequest, stream dr
 		return errors.New("invalid beacon id on chain info")
 	}
 
-	store, err := bp.createBoltStore()
+	store, err := bp.createDBStore(ctx)
 	if err != nil {
 		bp.log.Errorw("", "start_follow_chain", "unable to create store", "err", err)
 		return fmt.Errorf("unable to create store: %w", err)
@@ -1184,6 +1573,9 @@ func (bp *BeaconProcess) StartFollowChain(req *drand.StartSyncRequest, stream dr
 		return fmt.Errorf("unable to insert genesis block: %w", err)
 	}
 
+	// relays following a chain can keep only its most recent part
+	store = beacon.NewPruningStore(bp.log, store, info, bp.opts.Retention(), bp.opts.clock)
+
 	// add scheme store to handle scheme configuration on beacon storing process correctly
 	ss := beacon.NewSchemeStore(store, info.Scheme)
 
@@ -1197,6 +1589,10 @@ func (bp *BeaconProcess) StartFollowChain(req *drand.StartSyncRequest, stream dr
 	cbStore.AddCallback(addr, cb)
 	defer cbStore.RemoveCallback(addr)
 
+	if relays != nil {
+		return bp.followRelays(ctx, relays, cbStore, info, req.GetUpTo(), done)
+	}
+
 	syncer := beacon.NewSyncManager(&beacon.SyncConfig{
 		Log:         bp.log,
 		Store:       cbStore,
@@ -1205,6 +1601,7 @@ func (bp *BeaconProcess) StartFollowChain(req *drand.StartSyncRequest, stream dr
 		Client:      bp.privGateway,
 		Clock:       bp.opts.clock,
 		NodeAddr:    bp.priv.Public.Address(),
+		Reputation:  bp.reputation,
 	})
 	go syncer.Run()
 	defer syncer.Stop()
@@ -6,8 +6,12 @@ import (
 	"errors"
 	"fmt"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/drand/drand/chain"
 	"github.com/drand/drand/chain/beacon"
+	chainerrors "github.com/drand/drand/chain/errors"
 	"github.com/drand/drand/net"
 	"github.com/drand/drand/protobuf/drand"
 )
@@ -71,6 +75,12 @@ func (bp *BeaconProcess) PublicRand(ctx context.Context, in *drand.PublicRandReq
 		// fetch the correct entry or the next one if not found
 		beaconResp, err = bp.beacon.Store().Get(ctx, in.GetRound())
 	}
+	if errors.Is(err, chainerrors.ErrBeaconPruned) {
+		bp.log.Debugw("", "public_rand", "pruned_beacon", "round", in.GetRound(), "from", addr)
+		// the error type doesn't go over gRPC, the http server tells pruned
+		// rounds apart by their code
+		return nil, status.Errorf(codes.OutOfRange, "can't retrieve beacon: %v", err)
+	}
 	if err != nil || beaconResp == nil {
 		bp.log.Debugw("", "public_rand", "unstored_beacon", "round", in.GetRound(), "from", addr)
 		return nil, fmt.Errorf("can't retrieve beacon: %w %s", err, beaconResp)
@@ -83,6 +93,29 @@ func (bp *BeaconProcess) PublicRand(ctx context.Context, in *drand.PublicRandReq
 	return response, nil
 }
 
+// Contributors returns which nodes' partials formed the beacon of the requested
+// round. Only the beacons this node aggregated itself have a record.
+func (bp *BeaconProcess) Contributors(ctx context.Context, in *drand.ContributorsRequest) (*drand.ContributorsResponse, error) {
+	bp.state.Lock()
+	defer bp.state.Unlock()
+
+	if bp.beacon == nil {
+		return nil, errors.New("drand: beacon generation not started yet")
+	}
+	contributors, err := bp.beacon.Store().Contributors(ctx, in.GetRound())
+	if err != nil {
+		bp.log.Debugw("", "contributors", "unstored", "round", in.GetRound(), "err", err)
+		switch {
+		case errors.Is(err, chainerrors.ErrBeaconPruned):
+			return nil, status.Errorf(codes.OutOfRange, "can't retrieve contributors: %v", err)
+		case errors.Is(err, chainerrors.ErrNoContributorsStored):
+			return nil, status.Errorf(codes.NotFound, "can't retrieve contributors: %v", err)
+		}
+		return nil, fmt.Errorf("can't retrieve contributors: %w", err)
+	}
+	return contributors.ToProto(bp.newMetadata()), nil
+}
+
 // a proxy type so public streaming request can use the same logic as in priate
 // / protocol syncing request, even though the types differ, so it prevents
 // changing the protobuf structs.
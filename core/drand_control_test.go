@@ -1,13 +1,21 @@
 package core
 
 import (
+	"bytes"
+	"context"
 	"testing"
 	"time"
 
 	clock "github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 
+	"github.com/drand/drand/chain"
+	"github.com/drand/drand/chain/memdb"
 	"github.com/drand/drand/key"
 	"github.com/drand/drand/log"
+	"github.com/drand/drand/protobuf/drand"
+	"github.com/drand/drand/test"
 	"github.com/drand/kyber"
 	"github.com/drand/kyber/util/random"
 )
@@ -103,3 +111,52 @@ func TestValidateGroupTransitionTime(t *testing.T) {
 		t.Fatal("unexpected validation error", err)
 	}
 }
+
+type testBackupStream struct {
+	grpc.ServerStream
+	chunks [][]byte
+}
+
+func (s *testBackupStream) Send(c *drand.BackupChunk) error {
+	s.chunks = append(s.chunks, c.GetData())
+	return nil
+}
+
+func TestChunkWriter(t *testing.T) {
+	data := make([]byte, 2*backupChunkSize+10)
+	random.Bytes(data, random.New())
+
+	stream := new(testBackupStream)
+	w := &chunkWriter{stream: stream}
+	// writes both smaller and larger than a chunk
+	for _, part := range [][]byte{data[:10], data[10 : backupChunkSize+20], data[backupChunkSize+20:]} {
+		n, err := w.Write(part)
+		require.NoError(t, err)
+		require.Equal(t, len(part), n)
+	}
+	require.NoError(t, w.flush())
+
+	require.Len(t, stream.chunks, 3)
+	for _, c := range stream.chunks {
+		require.LessOrEqual(t, len(c), backupChunkSize)
+	}
+	require.Equal(t, data, bytes.Join(stream.chunks, nil))
+	require.Equal(t, len(data), w.size)
+}
+
+func TestFillChainCompleteness(t *testing.T) {
+	ctx := context.Background()
+	store := memdb.NewStore(test.Logger(t), 10)
+
+	var status drand.ChainStoreStatus
+	require.NoError(t, fillChainCompleteness(ctx, store, &status))
+	require.Zero(t, status.Stored)
+
+	for _, r := range []uint64{0, 3, 4, 7} {
+		require.NoError(t, store.Put(ctx, &chain.Beacon{Round: r}))
+	}
+	require.NoError(t, fillChainCompleteness(ctx, store, &status))
+	require.Equal(t, uint64(3), status.FirstRound)
+	require.Equal(t, uint64(3), status.Stored)
+	require.Equal(t, uint64(2), status.Missing)
+}
@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	"github.com/drand/drand/chain"
+	"github.com/drand/drand/chain/beacon"
 	"github.com/drand/drand/common"
 	dhttp "github.com/drand/drand/http"
 	"github.com/drand/drand/key"
@@ -29,6 +30,9 @@ type DrandDaemon struct {
 
 	handler *dhttp.DrandHandler
 
+	// bounds the sync streams served to other nodes, for all the beacons
+	syncLimiter *beacon.SyncLimiter
+
 	opts *Config
 	log  log.Logger
 
@@ -55,6 +59,7 @@ func NewDrandDaemon(c *Config) (*DrandDaemon, error) {
 		initialStores:   make(map[string]*key.Store),
 		beaconProcesses: make(map[string]*BeaconProcess),
 		chainHashes:     make(map[string]string),
+		syncLimiter:     beacon.NewSyncLimiter(c.clock, c.SyncLimits()),
 	}
 
 	// Add callback to register a new handler for http server after finishing DKG successfully
@@ -323,5 +328,9 @@ func (dd *DrandDaemon) LoadBeaconFromStore(beaconID string, store key.Store) (*B
 		bp.StartBeacon(catchup)
 	}
 
+	if err := bp.ResumeDKG(); err != nil {
+		dd.log.Errorw(fmt.Sprintf("beacon id [%s]: unable to resume the dkg", beaconID), "err", err)
+	}
+
 	return bp, nil
 }
@@ -197,6 +197,59 @@ func (dd *DrandDaemon) BackupDatabase(ctx context.Context, in *drand.BackupDBReq
 	return bp.BackupDatabase(ctx, in)
 }
 
+// StreamBackup sends a backup of the database of a beacon over the stream.
+func (dd *DrandDaemon) StreamBackup(in *drand.StreamBackupRequest, stream drand.Control_StreamBackupServer) error {
+	bp, err := dd.getBeaconProcessFromRequest(in.GetMetadata())
+	if err != nil {
+		return err
+	}
+
+	return bp.StreamBackup(in, stream)
+}
+
+// RestoreDatabase replaces the database of a beacon with a backup.
+func (dd *DrandDaemon) RestoreDatabase(ctx context.Context, in *drand.RestoreDBRequest) (*drand.RestoreDBResponse, error) {
+	bp, err := dd.getBeaconProcessFromRequest(in.GetMetadata())
+	if err != nil {
+		return nil, err
+	}
+
+	return bp.RestoreDatabase(ctx, in)
+}
+
+// MigrateDatabase rewrites the beacons of the database with the current
+// encoding.
+func (dd *DrandDaemon) MigrateDatabase(ctx context.Context, in *drand.MigrateDBRequest) (*drand.MigrateDBResponse, error) {
+	bp, err := dd.getBeaconProcessFromRequest(in.GetMetadata())
+	if err != nil {
+		return nil, err
+	}
+
+	return bp.MigrateDatabase(ctx, in)
+}
+
+// PauseBeacon stops the node from sending its partial signatures for the
+// beacon of the request.
+func (dd *DrandDaemon) PauseBeacon(ctx context.Context, in *drand.PauseBeaconRequest) (*drand.PauseBeaconResponse, error) {
+	bp, err := dd.getBeaconProcessFromRequest(in.GetMetadata())
+	if err != nil {
+		return nil, err
+	}
+
+	return bp.PauseBeacon(ctx, in)
+}
+
+// ResumeBeacon makes the node send its partial signatures again for the beacon
+// of the request.
+func (dd *DrandDaemon) ResumeBeacon(ctx context.Context, in *drand.ResumeBeaconRequest) (*drand.ResumeBeaconResponse, error) {
+	bp, err := dd.getBeaconProcessFromRequest(in.GetMetadata())
+	if err != nil {
+		return nil, err
+	}
+
+	return bp.ResumeBeacon(ctx, in)
+}
+
 func (dd *DrandDaemon) StartFollowChain(in *drand.StartSyncRequest, stream drand.Control_StartFollowChainServer) error {
 	dd.log.Debugw("StartFollowChain", "requested_chainhash", in.Metadata.ChainHash)
 	bp, err := dd.getBeaconProcessFromRequest(in.GetMetadata())
@@ -3,6 +3,11 @@ package core
 import (
 	"context"
 
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/drand/drand/chain/beacon"
+	"github.com/drand/drand/net"
 	"github.com/drand/drand/protobuf/common"
 	"github.com/drand/drand/protobuf/drand"
 )
@@ -56,6 +61,16 @@ func (dd *DrandDaemon) Home(c context.Context, in *drand.HomeRequest) (*drand.Ho
 	return &drand.HomeResponse{Metadata: ctx}, nil
 }
 
+// Contributors replies with the nodes whose partials formed the beacon of a round
+func (dd *DrandDaemon) Contributors(ctx context.Context, in *drand.ContributorsRequest) (*drand.ContributorsResponse, error) {
+	bp, err := dd.getBeaconProcessFromRequest(in.GetMetadata())
+	if err != nil {
+		return nil, err
+	}
+
+	return bp.Contributors(ctx, in)
+}
+
 // ChainInfo replies with the chain information this node participates to
 func (dd *DrandDaemon) ChainInfo(ctx context.Context, in *drand.ChainInfoRequest) (*drand.ChainInfoPacket, error) {
 	bp, err := dd.getBeaconProcessFromRequest(in.GetMetadata())
@@ -94,7 +109,32 @@ func (dd *DrandDaemon) SyncChain(in *drand.SyncRequest, stream drand.Protocol_Sy
 		return err
 	}
 
-	return bp.SyncChain(in, stream)
+	addr := net.RemoteAddress(stream.Context())
+	release, err := dd.syncLimiter.Acquire(addr)
+	if err != nil {
+		dd.log.Debugw("rejecting sync request", "from", addr, "err", err)
+		return err
+	}
+	defer release()
+	if err := stream.SendHeader(metadata.Pairs(net.SyncAcceptedHeader, "true")); err != nil {
+		return err
+	}
+
+	return bp.SyncChain(in, &throttledSyncStream{stream, dd.syncLimiter})
+}
+
+// throttledSyncStream sends the beacons within the bandwidth budget of the
+// sync streams
+type throttledSyncStream struct {
+	drand.Protocol_SyncChainServer
+	limiter *beacon.SyncLimiter
+}
+
+func (s *throttledSyncStream) Send(b *drand.BeaconPacket) error {
+	if err := s.limiter.Wait(s.Context(), proto.Size(b)); err != nil {
+		return err
+	}
+	return s.Protocol_SyncChainServer.Send(b)
 }
 
 // GetIdentity returns the identity of this drand node
@@ -43,6 +43,15 @@ func (d *drandProxy) Get(ctx context.Context, round uint64) (client.Result, erro
 	}, nil
 }
 
+// Contributors returns which nodes' partials formed the beacon of a round
+func (d *drandProxy) Contributors(ctx context.Context, round uint64) (*chain.Contributors, error) {
+	resp, err := d.r.Contributors(ctx, &drand.ContributorsRequest{Round: round})
+	if err != nil {
+		return nil, err
+	}
+	return chain.ContributorsFromProto(resp), nil
+}
+
 // Watch returns new randomness as it becomes available.
 func (d *drandProxy) Watch(ctx context.Context) <-chan client.Result {
 	proxy := newStreamProxy(ctx)
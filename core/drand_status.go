@@ -3,6 +3,7 @@ package core
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/drand/drand/protobuf/drand"
 )
@@ -81,12 +82,36 @@ func StatusResponseToString(status *drand.StatusResponse) string {
 	fmt.Fprintf(output, "* ChainStore \n")
 	fmt.Fprintf(output, " - IsEmpty: %t \n", status.ChainStore.IsEmpty)
 	fmt.Fprintf(output, " - LastRound: %d \n", status.ChainStore.LastRound)
+	if !status.ChainStore.IsEmpty {
+		fmt.Fprintf(output, " - FirstRound: %d \n", status.ChainStore.FirstRound)
+		fmt.Fprintf(output, " - Stored: %d \n", status.ChainStore.Stored)
+		fmt.Fprintf(output, " - Missing: %d \n", status.ChainStore.Missing)
+	}
 	fmt.Fprintf(output, "* BeaconProcess \n")
 	fmt.Fprintf(output, " - Status: %s \n", beaconStatus)
 	fmt.Fprintf(output, " - Stopped: %t \n", status.Beacon.IsStopped)
 	fmt.Fprintf(output, " - Started: %t \n", status.Beacon.IsStarted)
 	fmt.Fprintf(output, " - Serving: %t \n", status.Beacon.IsServing)
 	fmt.Fprintf(output, " - Running: %t \n", status.Beacon.IsRunning)
+	fmt.Fprintf(output, " - Paused: %t \n", status.Beacon.IsPaused)
+	if partials := status.GetPartials(); len(partials) > 0 {
+		fmt.Fprintf(output, "* Partials\n")
+		for _, p := range partials {
+			fmt.Fprintf(output, " - %s (index %d) -> missed %d/%d rounds, latency mean %.0fms max %.0fms, last round %d\n",
+				p.Address, p.Index, p.Missed, p.Rounds, p.MeanLatencyMs, p.MaxLatencyMs, p.LastRound)
+		}
+	}
+	if reputation := status.GetReputation(); len(reputation) > 0 {
+		fmt.Fprintf(output, "* Reputation\n")
+		for _, p := range reputation {
+			fmt.Fprintf(output, " - %s -> score %d, invalid %d, timeouts %d, out of round %d",
+				p.Address, p.Score, p.Invalid, p.Timeouts, p.OutOfRound)
+			if p.Banned {
+				fmt.Fprintf(output, ", banned until %s", time.Unix(p.BannedUntil, 0).Format(time.RFC3339))
+			}
+			fmt.Fprintf(output, "\n")
+		}
+	}
 	if conns := status.GetConnections(); len(conns) > 0 {
 		fmt.Fprintf(output, "* Network visibility\n")
 		for addr, ok := range conns {
@@ -14,8 +14,13 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaveworks/common/fs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/drand/drand/chain"
+	"github.com/drand/drand/chain/beacon"
+	"github.com/drand/drand/chain/boltdb"
+	"github.com/drand/drand/client"
 	"github.com/drand/drand/common/scheme"
 	"github.com/drand/drand/key"
 	"github.com/drand/drand/net"
@@ -102,6 +107,65 @@ func TestRunDKG(t *testing.T) {
 	assert.Equal(t, int64(449884810), group.GenesisTime)
 }
 
+// Test that the gossip fanout set by the leader is part of the group, and that
+// the group produces beacons with it
+func TestRunDKGGossip(t *testing.T) {
+	n := 5
+	p := 1 * time.Second
+	sch, beaconID := scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv()
+
+	dt := NewDrandTestScenario(t, n, key.DefaultThreshold(n), p, sch, beaconID)
+	dt.gossipFanout = 2
+
+	group := dt.RunDKG()
+	require.Equal(t, 2, group.GossipFanout)
+	for _, node := range dt.nodes {
+		require.Equal(t, 2, node.drand.group.GossipFanout)
+	}
+
+	dt.SetMockClock(t, group.GenesisTime)
+	require.NoError(t, dt.WaitUntilChainIsServing(t, dt.nodes[0]))
+	for i := 1; i <= 3; i++ {
+		for _, node := range dt.nodes {
+			require.NoError(t, dt.WaitUntilRound(t, node, uint64(i)))
+		}
+		dt.AdvanceMockClock(t, group.Period)
+	}
+}
+
+// Test that a group with signatures on G1 runs its DKG over keys on G2 and
+// produces short beacons
+func TestRunDKGShortSig(t *testing.T) {
+	n := 4
+	p := 1 * time.Second
+	sch, ok := scheme.GetSchemeByID(scheme.ShortSigSchemeID)
+	require.True(t, ok)
+	beaconID := test.GetBeaconIDFromEnv()
+
+	dt := NewDrandTestScenario(t, n, key.DefaultThreshold(n), p, sch, beaconID)
+
+	group := dt.RunDKG()
+	require.Equal(t, sch, group.Scheme)
+	require.True(t, group.PublicKey.OfScheme(sch))
+
+	dt.SetMockClock(t, group.GenesisTime)
+	require.NoError(t, dt.WaitUntilChainIsServing(t, dt.nodes[0]))
+	for i := 1; i <= 2; i++ {
+		require.NoError(t, dt.WaitUntilRound(t, dt.nodes[0], uint64(i)))
+		dt.AdvanceMockClock(t, group.Period)
+	}
+
+	root := dt.nodes[0].drand
+	client := net.NewGrpcClientFromCertManager(root.opts.certmanager)
+	resp, err := client.PublicRand(context.Background(), root.priv.Public, &drand.PublicRandRequest{Round: 2})
+	require.NoError(t, err)
+	require.Len(t, resp.GetSignature(), 48)
+
+	info := chain.NewChainInfo(group)
+	b := chain.Beacon{Round: resp.GetRound(), Signature: resp.GetSignature(), PreviousSig: resp.GetPreviousSignature()}
+	require.NoError(t, info.Verifier().VerifyBeacon(b, info.PublicKey))
+}
+
 // Test dkg for a large quantity of nodes (22 nodes)
 func TestRunDKGLarge(t *testing.T) {
 	if testing.Short() {
@@ -518,7 +582,7 @@ func TestRunDKGResharePreempt(t *testing.T) {
 		require.NoError(t, err)
 
 		t.Log("Init reshare on leader")
-		_, err = client.InitReshareLeader(newN, Thr, timeout, 0, "unused secret", "", testBeaconOffset, beaconID)
+		_, err = client.InitReshareLeader(newN, Thr, timeout, 0, -1, "unused secret", "", testBeaconOffset, beaconID)
 
 		// Done resharing
 		if err == nil {
@@ -698,6 +762,283 @@ func TestDrandPublicRand(t *testing.T) {
 		t.Logf("Checking if the round we got (%d) is the expected one (%d) \n", resp.Round, i)
 		require.Equal(t, i, resp.Round)
 	}
+
+	// the node records which partials formed the beacons it aggregated
+	contributors, err := root.Contributors(ctx, &drand.ContributorsRequest{Round: max - 1})
+	require.NoError(t, err)
+	require.Equal(t, max-1, contributors.GetRound())
+	require.GreaterOrEqual(t, len(contributors.GetIndexes()), thr)
+	for _, idx := range contributors.GetIndexes() {
+		require.Less(t, int(idx), n)
+	}
+	require.Empty(t, contributors.GetPartials())
+
+	// unknown contributors are reported with their own code over gRPC
+	_, err = root.Contributors(ctx, &drand.ContributorsRequest{Round: max + 10})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// Test that a group keeping its beacons in memory produces and serves
+// randomness, while only keeping the genesis and the most recent rounds.
+func TestDrandPublicRandMemDB(t *testing.T) {
+	n := 4
+	thr := key.DefaultThreshold(n)
+	p := 1 * time.Second
+	sch, beaconID := scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv()
+	bufferSize := 3
+
+	dt := NewDrandTestScenario(t, n, thr, p, sch, beaconID,
+		WithDBStorageEngine(chain.MemDB), WithMemDBSize(bufferSize))
+
+	group := dt.RunDKG()
+
+	root := dt.nodes[0].drand
+	rootID := root.priv.Public
+
+	dt.SetMockClock(t, group.GenesisTime)
+	err := dt.WaitUntilChainIsServing(t, dt.nodes[0])
+	require.NoError(t, err)
+
+	err = dt.WaitUntilRound(t, dt.nodes[0], 1)
+	require.NoError(t, err)
+
+	for i := 0; i < 4; i++ {
+		dt.AdvanceMockClock(t, group.Period)
+
+		err = dt.WaitUntilRound(t, dt.nodes[0], uint64(i+2))
+		require.NoError(t, err)
+	}
+
+	client := net.NewGrpcClientFromCertManager(root.opts.certmanager)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	resp, err := client.PublicRand(ctx, rootID, new(drand.PublicRandRequest))
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), resp.Round)
+
+	resp, err = client.PublicRand(ctx, rootID, &drand.PublicRandRequest{Round: 4})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), resp.Round)
+
+	// the first rounds got evicted from the buffer
+	_, err = client.PublicRand(ctx, rootID, &drand.PublicRandRequest{Round: 1})
+	require.Error(t, err)
+
+	// but the genesis beacon is kept on top of them
+	_, err = root.memDBStore.Get(ctx, 0)
+	require.NoError(t, err)
+	sLen, err := root.memDBStore.Len(ctx)
+	require.NoError(t, err)
+	require.Equal(t, bufferSize+1, sLen)
+}
+
+func TestDrandBackupRestore(t *testing.T) {
+	n := 4
+	thr := key.DefaultThreshold(n)
+	p := 1 * time.Second
+	sch, beaconID := scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv()
+
+	dt := NewDrandTestScenario(t, n, thr, p, sch, beaconID)
+	group := dt.RunDKG()
+	root := dt.nodes[0]
+
+	dt.SetMockClock(t, group.GenesisTime)
+	err := dt.WaitUntilChainIsServing(t, root)
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		dt.AdvanceMockClock(t, group.Period)
+		err = dt.WaitUntilRound(t, root, uint64(i+2))
+		require.NoError(t, err)
+	}
+
+	ctx := context.Background()
+	tmp := t.TempDir()
+	backup := path.Join(tmp, "backup.db")
+	f, err := os.Create(backup)
+	require.NoError(t, err)
+	client, err := net.NewControlClient(root.drand.opts.controlPort)
+	require.NoError(t, err)
+	size, err := client.StreamBackup(ctx, beaconID, f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	stat, err := os.Stat(backup)
+	require.NoError(t, err)
+	require.Equal(t, stat.Size(), size)
+
+	for i := 0; i < 2; i++ {
+		dt.AdvanceMockClock(t, group.Period)
+		err = dt.WaitUntilRound(t, root, uint64(i+5))
+		require.NoError(t, err)
+	}
+
+	// a file that is not a backup is refused
+	garbage := path.Join(tmp, "garbage.db")
+	require.NoError(t, os.WriteFile(garbage, []byte("not a database"), 0o600))
+	_, err = root.drand.RestoreDatabase(ctx, &drand.RestoreDBRequest{InputFile: garbage})
+	require.Error(t, err)
+
+	// so is a backup with an invalid beacon
+	tampered := path.Join(tmp, "tampered")
+	require.NoError(t, os.Mkdir(tampered, 0o700))
+	content, err := os.ReadFile(backup)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path.Join(tampered, boltdb.BoltFileName), content, 0o600))
+	store, err := boltdb.NewBoltStore(test.Logger(t), tampered, nil)
+	require.NoError(t, err)
+	b, err := store.Get(ctx, 2)
+	require.NoError(t, err)
+	b.Signature[0] ^= 0xff
+	require.NoError(t, store.Put(ctx, b))
+	require.NoError(t, store.Close(ctx))
+	_, err = root.drand.RestoreDatabase(ctx, &drand.RestoreDBRequest{InputFile: path.Join(tampered, boltdb.BoltFileName)})
+	require.Error(t, err)
+
+	// the beacon kept running on its database
+	err = dt.WaitUntilRound(t, root, 6)
+	require.NoError(t, err)
+
+	resp, err := root.drand.RestoreDatabase(ctx, &drand.RestoreDBRequest{InputFile: backup})
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), resp.GetRestored())
+
+	// the beacon restarted on the backup and catches up with the network
+	err = dt.WaitUntilRound(t, root, 6)
+	require.NoError(t, err)
+	dt.AdvanceMockClock(t, group.Period)
+	err = dt.WaitUntilRound(t, root, 7)
+	require.NoError(t, err)
+}
+
+func TestDrandMigrateDatabase(t *testing.T) {
+	n := 4
+	thr := key.DefaultThreshold(n)
+	p := 1 * time.Second
+	sch, beaconID := scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv()
+
+	dt := NewDrandTestScenario(t, n, thr, p, sch, beaconID)
+	group := dt.RunDKG()
+	root := dt.nodes[0]
+
+	dt.SetMockClock(t, group.GenesisTime)
+	err := dt.WaitUntilChainIsServing(t, root)
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		dt.AdvanceMockClock(t, group.Period)
+		err = dt.WaitUntilRound(t, root, uint64(i+2))
+		require.NoError(t, err)
+	}
+
+	client, err := net.NewControlClient(root.drand.opts.controlPort)
+	require.NoError(t, err)
+	_, err = client.MigrateDBStatus(beaconID)
+	require.Error(t, err)
+
+	// the migration returns right away and runs in the background
+	resp, err := client.MigrateDB(beaconID)
+	require.NoError(t, err)
+	for resp.GetRunning() {
+		time.Sleep(10 * time.Millisecond)
+		resp, err = client.MigrateDBStatus(beaconID)
+		require.NoError(t, err)
+	}
+	require.Empty(t, resp.GetError())
+
+	// the chain is unchanged by the migration
+	dt.AdvanceMockClock(t, group.Period)
+	err = dt.WaitUntilRound(t, root, 4)
+	require.NoError(t, err)
+	dt.CheckPublicBeacon(root.addr, false)
+}
+
+func TestDrandPauseResume(t *testing.T) {
+	n := 4
+	thr := key.DefaultThreshold(n)
+	p := 1 * time.Second
+	sch, beaconID := scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv()
+
+	dt := NewDrandTestScenario(t, n, thr, p, sch, beaconID)
+	group := dt.RunDKG()
+	root := dt.nodes[0]
+
+	dt.SetMockClock(t, group.GenesisTime)
+	err := dt.WaitUntilChainIsServing(t, root)
+	require.NoError(t, err)
+
+	client, err := net.NewControlClient(root.drand.opts.controlPort)
+	require.NoError(t, err)
+	require.Error(t, client.ResumeBeacon(beaconID))
+	require.Error(t, client.PauseBeacon("unknown-beacon-id"))
+	require.NoError(t, client.PauseBeacon(beaconID))
+	status, err := client.Status(beaconID)
+	require.NoError(t, err)
+	require.True(t, status.GetBeacon().GetIsPaused())
+	_, err = os.Stat(root.drand.pauseFile())
+	require.NoError(t, err)
+
+	// the paused node keeps following the chain made by the others
+	for i := 0; i < 2; i++ {
+		dt.AdvanceMockClock(t, group.Period)
+		err = dt.WaitUntilRound(t, root, uint64(i+2))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, client.ResumeBeacon(beaconID))
+	status, err = client.Status(beaconID)
+	require.NoError(t, err)
+	require.False(t, status.GetBeacon().GetIsPaused())
+	_, err = os.Stat(root.drand.pauseFile())
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	dt.AdvanceMockClock(t, group.Period)
+	err = dt.WaitUntilRound(t, root, 4)
+	require.NoError(t, err)
+}
+
+func TestDrandSyncLimits(t *testing.T) {
+	n := 4
+	thr := key.DefaultThreshold(n)
+	p := 1 * time.Second
+	sch, beaconID := scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv()
+
+	// all the nodes run on the same host
+	dt := NewDrandTestScenario(t, n, thr, p, sch, beaconID, WithSyncLimits(0, 1, 0))
+	group := dt.RunDKG()
+	root := dt.nodes[0]
+
+	dt.SetMockClock(t, group.GenesisTime)
+	err := dt.WaitUntilChainIsServing(t, root)
+	require.NoError(t, err)
+	dt.AdvanceMockClock(t, group.Period)
+	err = dt.WaitUntilRound(t, root, 2)
+	require.NoError(t, err)
+
+	client := dt.nodes[1].drand.privGateway.ProtocolClient
+	peer := root.drand.priv.Public
+	req := &drand.SyncRequest{FromRound: 1, Metadata: &common.Metadata{BeaconID: beaconID}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var first chan *drand.BeaconPacket
+	require.Eventually(t, func() bool {
+		first, err = client.SyncChain(ctx, peer, req)
+		return err == nil
+	}, 5*time.Second, 50*time.Millisecond)
+	b := <-first
+	require.Equal(t, uint64(1), b.GetRound())
+
+	// another stream from the same host is rejected with a retryable status
+	// until the first one ends
+	_, err = client.SyncChain(context.Background(), peer, req)
+	require.True(t, beacon.IsBusy(err), "unexpected error %v", err)
+
+	cancel()
+	require.Eventually(t, func() bool {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		_, err := client.SyncChain(ctx, peer, req)
+		return err == nil
+	}, 5*time.Second, 50*time.Millisecond)
 }
 
 // Test if the we can correctly fetch the rounds after a DKG using the
@@ -916,7 +1257,7 @@ func TestDrandFollowChain(t *testing.T) {
 		cancel()
 
 		// check if the beacon is in the database
-		store, err := newNode.drand.createBoltStore()
+		store, err := newNode.drand.createDBStore(ctx)
 		require.NoError(t, err)
 		defer store.Close(ctx)
 
@@ -929,9 +1270,92 @@ func TestDrandFollowChain(t *testing.T) {
 	fn(0, resp.GetRound())
 }
 
+// This test makes sure a node can follow a chain from the public API of a node
+func TestDrandFollowChainFromRelays(t *testing.T) {
+	n, p := 4, 1*time.Second
+	sch, beaconID := scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv()
+
+	dt := NewDrandTestScenario(t, n, key.DefaultThreshold(n), p, sch, beaconID)
+
+	group := dt.RunDKG()
+	rootID := dt.nodes[0].drand.priv.Public
+
+	dt.SetMockClock(t, group.GenesisTime)
+	err := dt.WaitUntilChainIsServing(t, dt.nodes[0])
+	require.NoError(t, err)
+
+	for i := 0; i < 4; i++ {
+		dt.AdvanceMockClock(t, group.Period)
+		err := dt.WaitUntilRound(t, dt.nodes[0], uint64(i+2))
+		require.NoError(t, err)
+	}
+
+	newNode := dt.SetupNewNodes(t, 1)[0]
+	newClient, err := net.NewControlClient(newNode.drand.opts.controlPort)
+	require.NoError(t, err)
+
+	relays := []string{rootID.Address()}
+	hash := fmt.Sprintf("%x", chain.NewChainInfo(group).Hash())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the chain info of the relays must match the hash
+	_, errCh, err := newClient.StartFollowChainFromRelays(ctx, "deadbeef", relays, true, 3, beaconID)
+	require.NoError(t, err)
+	expectChanFail(t, errCh)
+
+	exp := uint64(4)
+	progress, errCh, err := newClient.StartFollowChainFromRelays(ctx, hash, relays, true, exp, beaconID)
+	require.NoError(t, err)
+	for goon := true; goon; {
+		select {
+		case p, ok := <-progress:
+			if ok && p.Current == exp {
+				goon = false
+			}
+		case e := <-errCh:
+			if errors.Is(e, io.EOF) {
+				goon = false
+				break
+			}
+			require.NoError(t, e)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timeout while following the chain")
+		}
+	}
+	cancel()
+
+	store, err := newNode.drand.createDBStore(context.Background())
+	require.NoError(t, err)
+	defer store.Close(context.Background())
+	lastB, err := store.Last(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, exp, lastB.Round)
+}
+
 // This test makes sure the "StartCheckChain" grpc method works fine
 //
 //nolint:funlen
+
+func TestRelaysClientInjected(t *testing.T) {
+	relays := []string{"127.0.0.1:4444", "https://relay.example.com", "/ip4/127.0.0.1/tcp/44544"}
+	hash := []byte("deadbeef")
+
+	// the http and gossip relays can't be reached without a constructor for them
+	_, err := relaysClient(nil, nil, relays, false, hash, "", test.Logger(t))
+	require.Error(t, err)
+
+	var got []string
+	errUnreachable := errors.New("unreachable")
+	newRelays := func(relays []string, chainHash []byte) ([]client.Client, []client.Option, error) {
+		require.Equal(t, hash, chainHash)
+		got = relays
+		return nil, nil, errUnreachable
+	}
+	_, err = relaysClient(nil, newRelays, relays, false, hash, "", test.Logger(t))
+	require.ErrorIs(t, err, errUnreachable)
+	require.Equal(t, []string{"https://relay.example.com", "/ip4/127.0.0.1/tcp/44544"}, got)
+}
 func TestDrandCheckChain(t *testing.T) {
 	n, p := 4, 1*time.Second
 	sch, beaconID := scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv()
@@ -999,7 +1423,7 @@ func TestDrandCheckChain(t *testing.T) {
 	dt.StopMockNode(dt.nodes[0].addr, false)
 
 	t.Logf(" \t\t --> Done, proceeding to modify store now.\n")
-	store, err := dt.nodes[0].drand.createBoltStore()
+	store, err := dt.nodes[0].drand.createDBStore(ctx)
 	require.NoError(t, err)
 
 	t.Logf(" \t\t --> Opened store. Getting 4th beacon\n")
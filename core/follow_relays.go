@@ -0,0 +1,171 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/drand/drand/chain"
+	"github.com/drand/drand/chain/beacon"
+	"github.com/drand/drand/client"
+	"github.com/drand/drand/log"
+	"github.com/drand/drand/net"
+	"github.com/drand/drand/protobuf/common"
+	"github.com/drand/drand/protobuf/drand"
+)
+
+// followRelays appends the beacons of the relays to the store until the round
+// upTo is stored, or until the context is canceled if upTo is 0
+func (bp *BeaconProcess) followRelays(ctx context.Context, relays client.Client, store chain.Store,
+	info *chain.Info, upTo uint64, done chan struct{}) error {
+	follower := beacon.NewClientFollower(&beacon.ClientFollowConfig{
+		Log:    bp.log,
+		Clock:  bp.opts.clock,
+		Client: relays,
+		Store:  store,
+		Info:   info,
+	})
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- follower.Follow(ctx, upTo)
+	}()
+
+	for {
+		select {
+		case <-done:
+			return nil
+		case err := <-errCh:
+			if err != nil {
+				return err
+			}
+			// the progress up to the last round is still being sent
+			errCh = nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// relaysClient returns a client fetching the beacons of the chain from the
+// given relays. The addresses of the public gRPC API of drand daemons are
+// reached over the gateway of our daemon, the other relays with the clients
+// built by newRelays.
+func relaysClient(gateway net.PublicClient, newRelays RelayClientsFunc, relays []string, tls bool, hash []byte,
+	beaconID string, l log.Logger) (client.Client, error) {
+	clients := make([]client.Client, 0, len(relays))
+	var others []string
+	for _, addr := range relays {
+		if !isDaemonAddress(addr) {
+			others = append(others, addr)
+			continue
+		}
+		clients = append(clients, &gatewayClient{
+			client:   gateway,
+			peer:     net.CreatePeer(addr, tls),
+			metadata: &common.Metadata{BeaconID: beaconID, ChainHash: hash},
+		})
+	}
+
+	opts := []client.Option{client.WithChainHash(hash), client.WithLogger(l)}
+	if len(others) > 0 {
+		if newRelays == nil {
+			return nil, fmt.Errorf("no client available for the relays %v", others)
+		}
+		relayClients, relayOpts, err := newRelays(others, hash)
+		if err != nil {
+			return nil, fmt.Errorf("unable to reach relays: %w", err)
+		}
+		clients = append(clients, relayClients...)
+		opts = append(opts, relayOpts...)
+	}
+	return client.Wrap(clients, opts...)
+}
+
+// isDaemonAddress returns false for the URLs of http relays and the
+// multiaddresses of gossip relays
+func isDaemonAddress(addr string) bool {
+	return !strings.HasPrefix(addr, "http://") && !strings.HasPrefix(addr, "https://") && !strings.HasPrefix(addr, "/")
+}
+
+// gatewayClient is a client fetching the beacons from the public API of a
+// drand daemon
+type gatewayClient struct {
+	client   net.PublicClient
+	peer     net.Peer
+	metadata *common.Metadata
+}
+
+// String returns the name of this client.
+func (g *gatewayClient) String() string {
+	return fmt.Sprintf("Gateway(%q)", g.peer.Address())
+}
+
+// Get returns randomness at a requested round
+func (g *gatewayClient) Get(ctx context.Context, round uint64) (client.Result, error) {
+	resp, err := g.client.PublicRand(ctx, g.peer, &drand.PublicRandRequest{Round: round, Metadata: g.metadata})
+	if err != nil {
+		return nil, err
+	}
+	return asRandomData(resp), nil
+}
+
+// Watch returns new randomness as it becomes available.
+func (g *gatewayClient) Watch(ctx context.Context) <-chan client.Result {
+	out := make(chan client.Result, 1)
+	stream, err := g.client.PublicRandStream(ctx, g.peer, &drand.PublicRandRequest{Metadata: g.metadata})
+	if err != nil {
+		close(out)
+		return out
+	}
+	go func() {
+		defer close(out)
+		for resp := range stream {
+			select {
+			case out <- asRandomData(resp):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// Info returns the parameters of the chain this client is connected to.
+func (g *gatewayClient) Info(ctx context.Context) (*chain.Info, error) {
+	resp, err := g.client.ChainInfo(ctx, g.peer, &drand.ChainInfoRequest{Metadata: g.metadata})
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, errors.New("no received group - unexpected gPRC response")
+	}
+	return chain.InfoFromProto(resp)
+}
+
+// RoundAt will return the most recent round of randomness that will be available
+// at time for the current client.
+func (g *gatewayClient) RoundAt(t time.Time) uint64 {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	info, err := g.Info(ctx)
+	if err != nil {
+		return 0
+	}
+	return chain.CurrentRound(t.Unix(), info.Period, info.GenesisTime)
+}
+
+// Close does nothing, the connections belong to the gateway
+func (g *gatewayClient) Close() error {
+	return nil
+}
+
+func asRandomData(r *drand.PublicRandResponse) *client.RandomData {
+	return &client.RandomData{
+		Rnd:               r.GetRound(),
+		Random:            r.GetRandomness(),
+		Sig:               r.GetSignature(),
+		PreviousSignature: r.GetPreviousSignature(),
+	}
+}
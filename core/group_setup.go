@@ -50,6 +50,7 @@ type setupManager struct {
 	beaconOffset  time.Duration
 	catchupPeriod time.Duration
 	beaconPeriod  time.Duration
+	gossipFanout  int
 	beaconID      string
 	scheme        common2.Scheme
 	dkgTimeout    time.Duration
@@ -74,6 +75,7 @@ type setupConfig struct {
 	leaderKey     *key.Identity
 	beaconPeriod  uint32
 	catchupPeriod uint32
+	gossipFanout  uint32
 	beaconID      string
 	schemeID      string
 	info          *drand.SetupInfoPacket
@@ -99,6 +101,9 @@ func newDKGSetup(c *setupConfig) (*setupManager, error) {
 	if !ok {
 		return nil, fmt.Errorf("scheme id received is not valid")
 	}
+	if !ofScheme(c.leaderKey, sch) {
+		return nil, fmt.Errorf("leader key is not a key of scheme %s", sch.ID)
+	}
 
 	sm := &setupManager{
 		expected:      n,
@@ -106,6 +111,7 @@ func newDKGSetup(c *setupConfig) (*setupManager, error) {
 		beaconOffset:  offset,
 		beaconPeriod:  time.Duration(c.beaconPeriod) * time.Second,
 		catchupPeriod: time.Duration(c.catchupPeriod) * time.Second,
+		gossipFanout:  int(c.gossipFanout),
 		scheme:        sch,
 		beaconID:      c.beaconID,
 		dkgTimeout:    dkgTimeout,
@@ -139,10 +145,15 @@ func newReshareSetup(
 		catchupPeriod = uint32(oldGroup.CatchupPeriod.Seconds())
 	}
 
+	gossipFanout := in.GossipFanout
+	if !in.GossipFanoutChanged {
+		gossipFanout = uint32(oldGroup.GossipFanout)
+	}
+
 	sm, err := newDKGSetup(&setupConfig{
 		l.Named("ResharingDKGSetup"),
 		c, leaderKey, beaconPeriod,
-		catchupPeriod, beaconID,
+		catchupPeriod, gossipFanout, beaconID,
 		schemeID, in.GetInfo(),
 	})
 	if err != nil {
@@ -193,6 +204,11 @@ func (s *setupManager) ReceivedKey(addr string, p *drand.SignalDKGPacket) error
 		return fmt.Errorf("invalid sig: %w", err)
 	}
 
+	if !ofScheme(newID, s.scheme) {
+		s.l.Errorw("identity key not of the scheme in ReceivedKey", "id", addr, "scheme", s.scheme.ID)
+		return fmt.Errorf("invalid key: not a key of scheme %s", s.scheme.ID)
+	}
+
 	s.l.Debugw("", "setup", "received_new_key", "id", newID.String())
 
 	s.pushKeyCh <- pushKey{
@@ -259,6 +275,7 @@ func (s *setupManager) createAndSend(keys []*key.Identity) {
 		ps := int64(s.beaconPeriod.Seconds())
 		genesis += (ps - genesis%ps)
 		group = key.NewGroup(keys, s.thr, genesis, s.beaconPeriod, s.catchupPeriod, s.scheme, s.beaconID)
+		group.GossipFanout = s.gossipFanout
 	} else {
 		genesis := s.oldGroup.GenesisTime
 		atLeast := s.clock.Now().Add(totalDKG).Unix()
@@ -266,6 +283,7 @@ func (s *setupManager) createAndSend(keys []*key.Identity) {
 		// "DefaultResharingOffset" time from now.
 		_, transition := chain.NextRound(atLeast, s.beaconPeriod, s.oldGroup.GenesisTime)
 		group = key.NewGroup(keys, s.thr, genesis, s.beaconPeriod, s.catchupPeriod, s.scheme, s.beaconID)
+		group.GossipFanout = s.gossipFanout
 		group.TransitionTime = transition
 		group.GenesisSeed = s.oldGroup.GetGenesisSeed()
 	}
@@ -285,6 +303,12 @@ func (s *setupManager) StopPreemptively() {
 	s.doneCh <- true
 }
 
+// ofScheme returns true if the key of the identity lives in the key group of
+// the scheme
+func ofScheme(id *key.Identity, sch common2.Scheme) bool {
+	return key.KeyOfScheme(id.Key, sch)
+}
+
 func validInitPacket(in *drand.SetupInfoPacket) (n, thr int, dkg time.Duration, err error) {
 	n = int(in.GetNodes())
 	thr = int(in.GetThreshold())
@@ -374,10 +398,15 @@ func (r *setupReceiver) PushDKGInfo(pg *drand.DKGInfoPacket) error {
 	if err != nil {
 		return fmt.Errorf("group from leader invalid: %w", err)
 	}
-	if err := key.DKGAuthScheme.Verify(r.leaderID.Key, group.Hash(), pg.Signature); err != nil {
+	if err := key.CryptoOfKey(r.leaderID.Key).DKGAuthScheme.Verify(r.leaderID.Key, group.Hash(), pg.Signature); err != nil {
 		r.l.Errorw("", "received", "group", "invalid_sig", err)
 		return fmt.Errorf("invalid group sig: %w", err)
 	}
+	for _, n := range group.Nodes {
+		if !ofScheme(n.Identity, group.Scheme) {
+			return fmt.Errorf("group from leader invalid: key of %s not a key of scheme %s", n.Address(), group.Scheme.ID)
+		}
+	}
 	checkGroup(r.l, group)
 	r.ch <- &dkgGroup{
 		group:   group,
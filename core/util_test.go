@@ -61,6 +61,7 @@ type DrandTestScenario struct {
 	newThr        int
 	period        time.Duration
 	catchupPeriod time.Duration
+	gossipFanout  int
 	scheme        scheme.Scheme
 	beaconID      string
 
@@ -199,12 +200,14 @@ func getSleepDuration() time.Duration {
 // NewDrandTest creates a drand test scenario with initial n nodes and ready to
 // run a DKG for the given threshold that will then launch the beacon with the
 // specified period
-func NewDrandTestScenario(t *testing.T, n, thr int, period time.Duration, sch scheme.Scheme, beaconID string) *DrandTestScenario {
+func NewDrandTestScenario(t *testing.T, n, thr int, period time.Duration, sch scheme.Scheme, beaconID string,
+	opts ...ConfigOption) *DrandTestScenario {
 	dt := new(DrandTestScenario)
 	beaconID = common.GetCanonicalBeaconID(beaconID)
 
+	opts = append([]ConfigOption{WithCallOption(grpc.WaitForReady(true))}, opts...)
 	daemons, drands, _, dir, certPaths := BatchNewDrand(
-		t, n, false, sch, beaconID, WithCallOption(grpc.WaitForReady(true)),
+		t, n, false, sch, beaconID, opts...,
 	)
 
 	dt.t = t
@@ -290,7 +293,7 @@ func (d *DrandTestScenario) RunDKG() *key.Group {
 
 		// TODO: Control Client needs every single parameter, not a protobuf type. This means that it will be difficult to extend
 		groupPacket, err := controlClient.InitDKGLeader(
-			d.n, d.thr, d.period, d.catchupPeriod, testDkgTimeout, nil, secret, testBeaconOffset, d.scheme.ID, d.beaconID)
+			d.n, d.thr, d.period, d.catchupPeriod, testDkgTimeout, d.gossipFanout, nil, secret, testBeaconOffset, d.scheme.ID, d.beaconID)
 		if err != nil {
 			errDetector <- err
 			return
@@ -632,7 +635,7 @@ func (d *DrandTestScenario) runLeaderReshare(
 
 	// Start reshare
 	d.t.Logf("[reshare:leader] init reshare")
-	finalGroup, err := client.InitReshareLeader(newN, newThr, timeout, 0, secret, "", testBeaconOffset, d.beaconID)
+	finalGroup, err := client.InitReshareLeader(newN, newThr, timeout, 0, -1, secret, "", testBeaconOffset, d.beaconID)
 	if err != nil {
 		d.t.Log("[reshare:leader] error: ", err)
 		errCh <- err
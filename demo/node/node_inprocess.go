@@ -186,7 +186,7 @@ func (l *LocalNode) RunDKG(nodes, thr int, timeout string, leader bool, leaderAd
 	var grp *drand.GroupPacket
 	var err error
 	if leader {
-		grp, err = cl.InitDKGLeader(nodes, thr, p, 0, t, nil, secretDKG, beaconOffset, l.scheme.ID, l.beaconID)
+		grp, err = cl.InitDKGLeader(nodes, thr, p, 0, t, 0, nil, secretDKG, beaconOffset, l.scheme.ID, l.beaconID)
 	} else {
 		leader := net.CreatePeer(leaderAddr, l.tls)
 		grp, err = cl.InitDKG(leader, nil, secretDKG, l.beaconID)
@@ -222,7 +222,7 @@ func (l *LocalNode) RunReshare(nodes, thr int, oldGroup string, timeout string,
 	var grp *drand.GroupPacket
 	var err error
 	if leader {
-		grp, err = cl.InitReshareLeader(nodes, thr, t, 0, secretReshare, oldGroup, beaconOffset, l.beaconID)
+		grp, err = cl.InitReshareLeader(nodes, thr, t, 0, -1, secretReshare, oldGroup, beaconOffset, l.beaconID)
 	} else {
 		leader := net.CreatePeer(leaderAddr, l.tls)
 		grp, err = cl.InitReshare(leader, secretReshare, oldGroup, false, l.beaconID)
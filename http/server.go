@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math"
 	"net/http"
@@ -15,8 +16,11 @@ import (
 	"github.com/go-chi/chi"
 	json "github.com/nikkolasg/hexjson"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/drand/drand/chain"
+	chainerrors "github.com/drand/drand/chain/errors"
 	"github.com/drand/drand/client"
 	"github.com/drand/drand/common"
 	"github.com/drand/drand/log"
@@ -52,6 +56,20 @@ type DrandHandler struct {
 	state   sync.RWMutex
 }
 
+// ContributorsClient is implemented by the clients able to tell which nodes'
+// partials formed the beacon of a round, like the proxy of a drand node.
+type ContributorsClient interface {
+	Contributors(ctx context.Context, round uint64) (*chain.Contributors, error)
+}
+
+// contributorsResponse is the JSON description of the contributors of a round
+type contributorsResponse struct {
+	Round    uint64   `json:"round"`
+	Indexes  []int    `json:"indexes"`
+	Bitmap   []byte   `json:"bitmap"`
+	Partials [][]byte `json:"partials,omitempty"`
+}
+
 type BeaconHandler struct {
 	// NOTE: should only be accessed via getChainInfo
 	chainInfo   *chain.Info
@@ -88,11 +106,14 @@ func New(ctx context.Context, version string, logger log.Logger) (*DrandHandler,
 
 	mux.HandleFunc("/{"+chainHashParamKey+"}/public/latest", withCommonHeaders(version, handler.LatestRand))
 	mux.HandleFunc("/{"+chainHashParamKey+"}/public/{"+roundParamKey+"}", withCommonHeaders(version, handler.PublicRand))
+	mux.HandleFunc("/{"+chainHashParamKey+"}/public/{"+roundParamKey+"}/contributors",
+		withCommonHeaders(version, handler.Contributors))
 	mux.HandleFunc("/{"+chainHashParamKey+"}/info", withCommonHeaders(version, handler.ChainInfo))
 	mux.HandleFunc("/{"+chainHashParamKey+"}/health", withCommonHeaders(version, handler.Health))
 
 	mux.HandleFunc("/public/latest", withCommonHeaders(version, handler.LatestRand))
 	mux.HandleFunc("/public/{"+roundParamKey+"}", withCommonHeaders(version, handler.PublicRand))
+	mux.HandleFunc("/public/{"+roundParamKey+"}/contributors", withCommonHeaders(version, handler.Contributors))
 	mux.HandleFunc("/info", withCommonHeaders(version, handler.ChainInfo))
 	mux.HandleFunc("/health", withCommonHeaders(version, handler.Health))
 	mux.HandleFunc("/chains", withCommonHeaders(version, handler.ChainHashes))
@@ -379,6 +400,13 @@ func (h *DrandHandler) PublicRand(w http.ResponseWriter, r *http.Request) {
 	}
 
 	data, err := h.getRand(r.Context(), chainHashHex, info, roundN)
+	if isPruned(err) {
+		// the round exists but this node doesn't keep it anymore
+		w.Header().Set("Cache-Control", "must-revalidate, no-cache, max-age=0")
+		w.WriteHeader(http.StatusGone)
+		h.log.Debugw("", "http_server", "request for pruned round", "client", r.RemoteAddr, "req", url.PathEscape(r.URL.Path))
+		return
+	}
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		h.log.Warnw("", "http_server", "failed to get randomness", "client", r.RemoteAddr, "req", url.PathEscape(r.URL.Path), "err", err)
@@ -398,6 +426,96 @@ func (h *DrandHandler) PublicRand(w http.ResponseWriter, r *http.Request) {
 	http.ServeContent(w, r, "rand.json", roundExpectedTime, bytes.NewReader(data))
 }
 
+// Contributors replies with the indexes of the nodes whose partials formed the
+// beacon of the requested round, when the node serving the chain aggregated it.
+func (h *DrandHandler) Contributors(w http.ResponseWriter, r *http.Request) {
+	roundN, err := readRound(r)
+	if err != nil || roundN == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		h.log.Warnw("", "http_server", "failed to parse client round", "client", r.RemoteAddr, "req", url.PathEscape(r.URL.Path))
+		return
+	}
+
+	chainHashHex, err := readChainHash(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	bh, err := h.getBeaconHandler(chainHashHex)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	cc, ok := bh.client.(ContributorsClient)
+	if !ok {
+		w.WriteHeader(http.StatusNotImplemented)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+	contributors, err := cc.Contributors(ctx, roundN)
+	switch {
+	case isPruned(err):
+		w.Header().Set("Cache-Control", "must-revalidate, no-cache, max-age=0")
+		w.WriteHeader(http.StatusGone)
+		return
+	case hasNoContributors(err):
+		w.Header().Set("Cache-Control", "must-revalidate, no-cache, max-age=0")
+		w.WriteHeader(http.StatusNotFound)
+		return
+	case err != nil:
+		w.WriteHeader(http.StatusInternalServerError)
+		h.log.Warnw("", "http_server", "failed to get contributors", "client", r.RemoteAddr, "req", url.PathEscape(r.URL.Path), "err", err)
+		return
+	}
+
+	data, err := json.Marshal(&contributorsResponse{
+		Round:    contributors.Round,
+		Indexes:  contributors.Indexes(),
+		Bitmap:   contributors.Bitmap,
+		Partials: contributors.Partials,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		h.log.Warnw("", "http_server", "failed to marshal contributors", "client", r.RemoteAddr, "req", url.PathEscape(r.URL.Path), "err", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=604800, immutable")
+	_, _ = w.Write(data)
+}
+
+// hasNoContributors tells whether err reports a round whose contributors are
+// not known. Nodes reply over gRPC with a NotFound status in that case.
+func hasNoContributors(err error) bool {
+	if err == nil {
+		return false
+	}
+	return errors.Is(err, chainerrors.ErrNoContributorsStored) || grpcCode(err) == codes.NotFound
+}
+
+// isPruned tells whether err reports a round deleted by the retention policy of
+// the node. Nodes reply over gRPC with an OutOfRange status in that case.
+func isPruned(err error) bool {
+	if err == nil {
+		return false
+	}
+	return errors.Is(err, chainerrors.ErrBeaconPruned) || grpcCode(err) == codes.OutOfRange
+}
+
+// grpcCode returns the code of the gRPC status err carries, even when the
+// client wrapped it.
+func grpcCode(err error) codes.Code {
+	var s interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &s) {
+		return s.GRPCStatus().Code()
+	}
+	return codes.Unknown
+}
+
 func (h *DrandHandler) LatestRand(w http.ResponseWriter, r *http.Request) {
 	chainHashHex, err := readChainHash(r)
 	if err != nil {
@@ -10,7 +10,11 @@ import (
 
 	json "github.com/nikkolasg/hexjson"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/drand/drand/chain"
+	chainerrors "github.com/drand/drand/chain/errors"
 	"github.com/drand/drand/client"
 	"github.com/drand/drand/client/grpc"
 	nhttp "github.com/drand/drand/client/http"
@@ -306,3 +310,115 @@ func TestHTTPHealth(t *testing.T) {
 	}
 	resp.Body.Close()
 }
+
+// prunedClient reports the rounds below from as pruned, like a node running
+// with a retention policy does over gRPC
+type prunedClient struct {
+	client.Client
+	from uint64
+}
+
+func (p *prunedClient) Get(ctx context.Context, round uint64) (client.Result, error) {
+	if round < p.from {
+		return nil, fmt.Errorf("client: %w", status.Errorf(codes.OutOfRange, "can't retrieve beacon: round %d", round))
+	}
+	return p.Client.Get(ctx, round)
+}
+
+func TestHTTPPruned(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c, _ := withClient(t)
+
+	handler, err := New(ctx, "", nil)
+	require.NoError(t, err)
+
+	info, err := c.Info(ctx)
+	require.NoError(t, err)
+
+	handler.RegisterNewBeaconHandler(&prunedClient{Client: c, from: 2}, info.HashString())
+
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	server := http.Server{Handler: handler.GetHTTPHandler()}
+	go func() { _ = server.Serve(listener) }()
+	defer func() { _ = server.Shutdown(ctx) }()
+
+	require.NoError(t, nhttp.IsServerReady(listener.Addr().String()))
+
+	resp := getWithCtx(ctx, fmt.Sprintf("http://%s/%s/public/1", listener.Addr().String(), info.HashString()), t)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusGone, resp.StatusCode)
+
+	resp = getWithCtx(ctx, fmt.Sprintf("http://%s/%s/public/2", listener.Addr().String(), info.HashString()), t)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+// contributorsClient knows the contributors of the even rounds, like a node
+// that only aggregated some of the beacons itself
+type contributorsClient struct {
+	client.Client
+}
+
+func (c *contributorsClient) Contributors(_ context.Context, round uint64) (*chain.Contributors, error) {
+	switch {
+	case round == 1:
+		return nil, fmt.Errorf("can't retrieve contributors: %w", chainerrors.ErrBeaconPruned)
+	case round%2 != 0:
+		return nil, status.Errorf(codes.NotFound, "can't retrieve contributors: %v", chainerrors.ErrNoContributorsStored)
+	}
+	contributors := chain.NewContributors(round, 4, []int{0, 2, 3})
+	contributors.Partials = [][]byte{{0x00}, {0x02}, {0x03}}
+	return contributors, nil
+}
+
+func TestHTTPContributors(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c, _ := withClient(t)
+
+	handler, err := New(ctx, "", nil)
+	require.NoError(t, err)
+
+	info, err := c.Info(ctx)
+	require.NoError(t, err)
+
+	handler.RegisterNewBeaconHandler(&contributorsClient{Client: c}, info.HashString())
+
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	server := http.Server{Handler: handler.GetHTTPHandler()}
+	go func() { _ = server.Serve(listener) }()
+	defer func() { _ = server.Shutdown(ctx) }()
+
+	require.NoError(t, nhttp.IsServerReady(listener.Addr().String()))
+
+	url := fmt.Sprintf("http://%s/%s/public/2/contributors", listener.Addr().String(), info.HashString())
+	resp := getWithCtx(ctx, url, t)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body := make(map[string]interface{})
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, float64(2), body["round"])
+	require.Equal(t, []interface{}{float64(0), float64(2), float64(3)}, body["indexes"])
+	require.Equal(t, "0d", body["bitmap"])
+	require.Len(t, body["partials"], 3)
+
+	url = fmt.Sprintf("http://%s/%s/public/3/contributors", listener.Addr().String(), info.HashString())
+	resp = getWithCtx(ctx, url, t)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	url = fmt.Sprintf("http://%s/%s/public/1/contributors", listener.Addr().String(), info.HashString())
+	resp = getWithCtx(ctx, url, t)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusGone, resp.StatusCode)
+
+	// a chain served by a client that can't tell the contributors
+	handler.RegisterNewBeaconHandler(c, info.HashString())
+	url = fmt.Sprintf("http://%s/%s/public/2/contributors", listener.Addr().String(), info.HashString())
+	resp = getWithCtx(ctx, url, t)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusNotImplemented, resp.StatusCode)
+}
@@ -0,0 +1,49 @@
+// Package schemes holds the registry of the beacon schemes. It is internal so
+// that the only way to register a scheme is key.RegisterScheme, which registers
+// the groups and signature schemes it uses at the same time: common/scheme only
+// looks the schemes up.
+package schemes
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// registry holds the schemes by ID, and their IDs in registration order
+var registry = struct {
+	sync.RWMutex
+	ids     []string
+	schemes map[string]interface{}
+}{schemes: make(map[string]interface{})}
+
+// Register adds the scheme to the schemes available by ID
+func Register(id string, sch interface{}) error {
+	if id == "" {
+		return errors.New("scheme ID can't be empty")
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+	if _, ok := registry.schemes[id]; ok {
+		return fmt.Errorf("scheme [%s] is already registered", id)
+	}
+	registry.schemes[id] = sch
+	registry.ids = append(registry.ids, id)
+	return nil
+}
+
+// Get returns the scheme registered with this ID, if any
+func Get(id string) (sch interface{}, found bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	sch, found = registry.schemes[id]
+	return sch, found
+}
+
+// IDs returns the IDs of the registered schemes, in registration order
+func IDs() (ids []string) {
+	registry.RLock()
+	defer registry.RUnlock()
+	return append(ids, registry.ids...)
+}
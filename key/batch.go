@@ -0,0 +1,110 @@
+package key
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	"github.com/drand/kyber"
+	"github.com/drand/kyber/share"
+	"github.com/drand/kyber/sign/tbls"
+)
+
+// batchScalarSize is the size in bytes of the random coefficients used in
+// batch verification: an invalid partial passes the check with a probability
+// of 2^-128.
+const batchScalarSize = 16
+
+type hashablePoint interface {
+	Hash([]byte) kyber.Point
+}
+
+// BatchVerifyPartials checks that all the partial signatures are valid
+// signatures of msg under the public polynomial, at the cost of a single
+// pairing check instead of one per partial. Each partial is weighted by a
+// random coefficient so that invalid partials can't cancel each other out. The
+// error doesn't tell which partial is invalid: the caller must fall back to
+// verifying each partial with ThresholdScheme.VerifyPartial to find it.
+func (c *Crypto) BatchVerifyPartials(public *share.PubPoly, msg []byte, partials [][]byte) error {
+	if len(partials) == 0 {
+		return nil
+	}
+	hm, err := c.HashToSigGroup(msg)
+	if err != nil {
+		return err
+	}
+
+	_, commits := public.Info()
+	// the weighted sum of the public shares is computed from the commitments
+	// of the polynomial: sum(r_i * p(x_i)) = sum_j(c_j * sum_i(r_i * x_i^j)),
+	// which only takes one scalar multiplication per commitment
+	coeffs := make([]kyber.Scalar, len(commits))
+	for j := range coeffs {
+		coeffs[j] = c.KeyGroup.Scalar().Zero()
+	}
+	sig := c.SigGroup.Point().Null()
+	buff := make([]byte, batchScalarSize)
+	for _, partial := range partials {
+		idx, err := c.ThresholdScheme.IndexOf(partial)
+		if err != nil {
+			return err
+		}
+		sh := tbls.SigShare(partial)
+		point := c.SigGroup.Point()
+		if err := point.UnmarshalBinary(sh.Value()); err != nil {
+			return fmt.Errorf("invalid partial of index %d: %w", idx, err)
+		}
+		if _, err := rand.Read(buff); err != nil {
+			return err
+		}
+		r := c.KeyGroup.Scalar().SetBytes(buff)
+		sig.Add(sig, point.Mul(r, point))
+
+		x := c.KeyGroup.Scalar().SetInt64(1 + int64(idx))
+		xj := r
+		for j := range coeffs {
+			coeffs[j].Add(coeffs[j], xj)
+			xj = c.KeyGroup.Scalar().Mul(xj, x)
+		}
+	}
+	pub := c.KeyGroup.Point().Null()
+	for j, commit := range commits {
+		pub.Add(pub, c.KeyGroup.Point().Mul(coeffs[j], commit))
+	}
+
+	// e(sum(r_i * P_i), H(m)) == e(g, sum(r_i * S_i))
+	if !c.validatePairing(pub, hm, sig) {
+		return errors.New("invalid partial signatures")
+	}
+	return nil
+}
+
+// RecoverVerified reconstructs the full signature from a threshold of partial
+// signatures like ThresholdScheme.Recover does, but without verifying each of
+// them again. It must only be given partials that were already verified.
+func (c *Crypto) RecoverVerified(partials [][]byte, t, n int) ([]byte, error) {
+	shares := make([]*share.PubShare, 0, len(partials))
+	for _, partial := range partials {
+		sh := tbls.SigShare(partial)
+		idx, err := sh.Index()
+		if err != nil {
+			continue
+		}
+		point := c.SigGroup.Point()
+		if err := point.UnmarshalBinary(sh.Value()); err != nil {
+			continue
+		}
+		shares = append(shares, &share.PubShare{I: idx, V: point})
+		if len(shares) >= t {
+			break
+		}
+	}
+	if len(shares) < t {
+		return nil, errors.New("not enough valid partial signatures")
+	}
+	sig, err := share.RecoverCommit(c.SigGroup, shares, t, n)
+	if err != nil {
+		return nil, err
+	}
+	return sig.MarshalBinary()
+}
@@ -0,0 +1,114 @@
+package key
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/common/scheme"
+	"github.com/drand/kyber/share"
+	"github.com/drand/kyber/util/random"
+)
+
+func signedPartials(t testing.TB, c *Crypto, n, thr int, msg []byte) (*share.PubPoly, [][]byte) {
+	priv := share.NewPriPoly(c.KeyGroup, thr, nil, random.New())
+	partials := make([][]byte, n)
+	for i, s := range priv.Shares(n) {
+		partial, err := c.ThresholdScheme.Sign(s, msg)
+		require.NoError(t, err)
+		partials[i] = partial
+	}
+	return priv.Commit(nil), partials
+}
+
+func TestBatchVerifyPartials(t *testing.T) {
+	for _, id := range []string{scheme.DefaultSchemeID, scheme.ShortSigSchemeID, scheme.RFC9380SchemeID} {
+		sch, ok := scheme.GetSchemeByID(id)
+		require.True(t, ok)
+		t.Run(id, func(t *testing.T) {
+			testBatchVerifyPartials(t, CryptoFor(sch))
+		})
+	}
+}
+
+func testBatchVerifyPartials(t *testing.T, c *Crypto) {
+	n, thr := 7, 4
+	msg := []byte("the message of the round")
+	pub, partials := signedPartials(t, c, n, thr, msg)
+
+	require.NoError(t, c.BatchVerifyPartials(pub, msg, nil))
+	require.NoError(t, c.BatchVerifyPartials(pub, msg, partials))
+	require.NoError(t, c.BatchVerifyPartials(pub, msg, partials[2:5]))
+	require.Error(t, c.BatchVerifyPartials(pub, []byte("another message"), partials))
+
+	// a partial signed over another message
+	_, others := signedPartials(t, c, n, thr, []byte("another message"))
+	invalid := append([][]byte{}, partials...)
+	invalid[3] = others[3]
+	require.Error(t, c.BatchVerifyPartials(pub, msg, invalid))
+
+	// a partial claiming another index
+	invalid = append([][]byte{}, partials...)
+	invalid[3] = append([]byte{}, partials[3]...)
+	invalid[3][1] = 4
+	require.Error(t, c.BatchVerifyPartials(pub, msg, invalid))
+
+	// two invalid partials whose errors cancel out without random coefficients
+	invalid = append([][]byte{}, partials...)
+	p1, p2 := c.SigGroup.Point(), c.SigGroup.Point()
+	require.NoError(t, p1.UnmarshalBinary(partials[1][2:]))
+	require.NoError(t, p2.UnmarshalBinary(partials[2][2:]))
+	delta := c.SigGroup.Point().Pick(random.New())
+	b1, err := p1.Add(p1, delta).MarshalBinary()
+	require.NoError(t, err)
+	b2, err := p2.Sub(p2, delta).MarshalBinary()
+	require.NoError(t, err)
+	invalid[1] = append(append([]byte{}, partials[1][:2]...), b1...)
+	invalid[2] = append(append([]byte{}, partials[2][:2]...), b2...)
+	require.Error(t, c.BatchVerifyPartials(pub, msg, invalid))
+
+	require.Error(t, c.BatchVerifyPartials(pub, msg, [][]byte{[]byte("too short")}))
+}
+
+func TestRecoverVerified(t *testing.T) {
+	for _, c := range []*Crypto{defaultCrypto, sigsOnG1Crypto} {
+		n, thr := 7, 4
+		msg := []byte("the message of the round")
+		pub, partials := signedPartials(t, c, n, thr, msg)
+
+		expected, err := c.ThresholdScheme.Recover(pub, msg, partials[1:6], thr, n)
+		require.NoError(t, err)
+		sig, err := c.RecoverVerified(partials[1:6], thr, n)
+		require.NoError(t, err)
+		require.Equal(t, expected, sig)
+		require.Len(t, sig, c.SigGroup.PointLen())
+		require.NoError(t, c.ThresholdScheme.VerifyRecovered(pub.Commit(), msg, sig))
+
+		_, err = c.RecoverVerified(partials[:thr-1], thr, n)
+		require.Error(t, err)
+	}
+}
+
+func BenchmarkVerifyPartials(b *testing.B) {
+	msg := []byte("the message of the round")
+	for _, n := range []int{16, 64} {
+		pub, partials := signedPartials(b, defaultCrypto, n, n/2+1, msg)
+		b.Run(fmt.Sprintf("individual-%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for _, partial := range partials {
+					if err := Scheme.VerifyPartial(pub, msg, partial); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("batch-%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if err := defaultCrypto.BatchVerifyPartials(pub, msg, partials); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
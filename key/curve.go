@@ -18,6 +18,8 @@ import (
 )
 
 // TODO: global variables are evil, make that a config
+// The variables below are the groups and schemes of the default scheme, with
+// keys on G1 and signatures on G2. CryptoFor returns those of a given scheme.
 
 // Pairing is the main pairing suite used by drand. New interesting curves
 // should be allowed by drand, such as BLS12-381.
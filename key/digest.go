@@ -0,0 +1,27 @@
+package key
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+// DigestFunc returns the message signed at the given round, following the
+// beacon with the given signature
+type DigestFunc func(round uint64, prevSig []byte) []byte
+
+// ChainedDigest is the digest of the chained schemes, where each beacon signs
+// the signature of the previous one along its round
+func ChainedDigest(round uint64, prevSig []byte) []byte {
+	h := sha256.New()
+	_, _ = h.Write(prevSig)
+	_ = binary.Write(h, binary.BigEndian, round)
+	return h.Sum(nil)
+}
+
+// UnchainedDigest is the digest of the unchained schemes, where each beacon
+// only signs its round
+func UnchainedDigest(round uint64, _ []byte) []byte {
+	h := sha256.New()
+	_ = binary.Write(h, binary.BigEndian, round)
+	return h.Sum(nil)
+}
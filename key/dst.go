@@ -0,0 +1,131 @@
+package key
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+
+	"github.com/drand/kyber"
+	"github.com/drand/kyber/share"
+	"github.com/drand/kyber/sign/tbls"
+	bls12381 "github.com/kilic/bls12-381"
+)
+
+// HashToSigGroup hashes the message to the point of the signature group the
+// threshold scheme signs, as specified by RFC 9380 with the domain separation
+// tag of the scheme
+func (c *Crypto) HashToSigGroup(msg []byte) (kyber.Point, error) {
+	if c.dst == nil {
+		hashable, ok := c.SigGroup.Point().(hashablePoint)
+		if !ok {
+			return nil, errors.New("point needs to implement hashablePoint")
+		}
+		return hashable.Hash(msg), nil
+	}
+
+	// the curve library hashes with a fixed tag, so the point is hashed with
+	// the underlying implementation and converted through its encoding
+	var buff []byte
+	if c.sigsOnG1 {
+		g := bls12381.NewG1()
+		p, err := g.HashToCurve(msg, c.dst)
+		if err != nil {
+			return nil, err
+		}
+		buff = g.ToCompressed(p)
+	} else {
+		g := bls12381.NewG2()
+		p, err := g.HashToCurve(msg, c.dst)
+		if err != nil {
+			return nil, err
+		}
+		buff = g.ToCompressed(p)
+	}
+	p := c.SigGroup.Point()
+	return p, p.UnmarshalBinary(buff)
+}
+
+// dstScheme is the threshold BLS signature scheme of the schemes hashing with
+// their own domain separation tag. Its partial signatures are encoded like the
+// ones of the tbls package: the index of the share on 2 bytes, then the
+// signature.
+type dstScheme struct {
+	c *Crypto
+}
+
+func (s *dstScheme) sign(private kyber.Scalar, msg []byte) ([]byte, error) {
+	hm, err := s.c.HashToSigGroup(msg)
+	if err != nil {
+		return nil, err
+	}
+	return hm.Mul(private, hm).MarshalBinary()
+}
+
+func (s *dstScheme) verify(public kyber.Point, msg, sig []byte) error {
+	hm, err := s.c.HashToSigGroup(msg)
+	if err != nil {
+		return err
+	}
+	point := s.c.SigGroup.Point()
+	if err := point.UnmarshalBinary(sig); err != nil {
+		return err
+	}
+	if !s.c.validatePairing(public, hm, point) {
+		return errors.New("bls: invalid signature")
+	}
+	return nil
+}
+
+// Sign returns the partial signature of the message with the given share
+func (s *dstScheme) Sign(private *share.PriShare, msg []byte) ([]byte, error) {
+	sig, err := s.sign(private.V, msg)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := binary.Write(&buf, binary.BigEndian, uint16(private.I)); err != nil {
+		return nil, err
+	}
+	_, _ = buf.Write(sig)
+	return buf.Bytes(), nil
+}
+
+// IndexOf returns the index of the share that made the partial signature
+func (s *dstScheme) IndexOf(sig []byte) (int, error) {
+	if len(sig) != s.c.SigGroup.PointLen()+2 {
+		return -1, errors.New("invalid partial signature length")
+	}
+	return tbls.SigShare(sig).Index()
+}
+
+// VerifyPartial checks the partial signature against the public share of its
+// index
+func (s *dstScheme) VerifyPartial(public *share.PubPoly, msg, sig []byte) error {
+	sh := tbls.SigShare(sig)
+	idx, err := sh.Index()
+	if err != nil {
+		return err
+	}
+	return s.verify(public.Eval(idx).V, msg, sh.Value())
+}
+
+// VerifyRecovered checks the full signature against the distributed key
+func (s *dstScheme) VerifyRecovered(public kyber.Point, msg, sig []byte) error {
+	return s.verify(public, msg, sig)
+}
+
+// Recover reconstructs the full signature from a threshold of valid partial
+// signatures, skipping the invalid ones
+func (s *dstScheme) Recover(public *share.PubPoly, msg []byte, sigs [][]byte, t, n int) ([]byte, error) {
+	valid := make([][]byte, 0, t)
+	for _, sig := range sigs {
+		if s.VerifyPartial(public, msg, sig) != nil {
+			continue
+		}
+		valid = append(valid, sig)
+		if len(valid) >= t {
+			break
+		}
+	}
+	return s.c.RecoverVerified(valid, t, n)
+}
@@ -0,0 +1,89 @@
+package key
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/common/scheme"
+	"github.com/drand/kyber/share"
+	"github.com/drand/kyber/util/random"
+)
+
+// TestHashToSigGroupRFC9380 checks the hash to the curve against the test
+// vectors of RFC 9380, appendix J.9.1 and J.10.1, comparing the x coordinate
+// of the point hashed from the empty message
+func TestHashToSigGroupRFC9380(t *testing.T) {
+	vectors := []struct {
+		sigsOnG1 bool
+		dst      string
+		x        string
+	}{{
+		sigsOnG1: true,
+		dst:      "QUUX-V01-CS02-with-BLS12381G1_XMD:SHA-256_SSWU_RO_",
+		x:        "052926add2207b76ca4fa57a8734416c8dc95e24501772c814278700eed6d1e4e8cf62d9c09db0fac349612b759e79a1",
+	}, {
+		dst: "QUUX-V01-CS02-with-BLS12381G2_XMD:SHA-256_SSWU_RO_",
+		// the imaginary part of x comes first in the encoding
+		x: "05cb8437535e20ecffaef7752baddf98034139c38452458baeefab379ba13dff5bf5dd71b72418717047f5b0f37da03d" +
+			"0141ebfbdca40eb85b87142e130ab689c673cf60f1a3e98d69335266f30d9b8d4ac44c1038e9dcdd5393faf5c41fb78a",
+	}}
+	for _, v := range vectors {
+		c := NewCrypto(v.sigsOnG1, v.dst, UnchainedDigest)
+		p, err := c.HashToSigGroup([]byte(""))
+		require.NoError(t, err)
+		buff, err := p.MarshalBinary()
+		require.NoError(t, err)
+		// the 3 most significant bits are the flags of the compressed encoding
+		buff[0] &= 0x1f
+		require.Equal(t, v.x, hex.EncodeToString(buff))
+	}
+}
+
+func TestDSTScheme(t *testing.T) {
+	sch, ok := scheme.GetSchemeByID(scheme.RFC9380SchemeID)
+	require.True(t, ok)
+	c := CryptoFor(sch)
+	require.Equal(t, []byte(scheme.DSTG1), c.dst)
+	require.Same(t, c, CryptoFor(sch))
+	require.Same(t, sigsOnG1Crypto.KeyGroup, c.KeyGroup)
+
+	n, thr := 5, 3
+	msg := []byte("the message of the round")
+	priv := share.NewPriPoly(c.KeyGroup, thr, nil, random.New())
+	pub := priv.Commit(nil)
+	partials := make([][]byte, n)
+	for i, s := range priv.Shares(n) {
+		partial, err := c.ThresholdScheme.Sign(s, msg)
+		require.NoError(t, err)
+		require.Len(t, partial, 2+c.SigGroup.PointLen())
+		idx, err := c.ThresholdScheme.IndexOf(partial)
+		require.NoError(t, err)
+		require.Equal(t, i, idx)
+		require.NoError(t, c.ThresholdScheme.VerifyPartial(pub, msg, partial))
+		partials[i] = partial
+	}
+	require.NoError(t, c.BatchVerifyPartials(pub, msg, partials))
+	_, err := c.ThresholdScheme.IndexOf(partials[0][1:])
+	require.Error(t, err)
+
+	// an invalid partial is skipped when recovering
+	invalid := append([][]byte{}, partials...)
+	invalid[0], err = c.ThresholdScheme.Sign(priv.Shares(n)[0], []byte("another message"))
+	require.NoError(t, err)
+	require.Error(t, c.ThresholdScheme.VerifyPartial(pub, msg, invalid[0]))
+	sig, err := c.ThresholdScheme.Recover(pub, msg, invalid, thr, n)
+	require.NoError(t, err)
+	require.NoError(t, c.ThresholdScheme.VerifyRecovered(pub.Commit(), msg, sig))
+	_, err = c.ThresholdScheme.Recover(pub, msg, invalid[:thr], thr, n)
+	require.Error(t, err)
+
+	// the same key signs differently with the tag of the curve library
+	short, ok := scheme.GetSchemeByID(scheme.ShortSigSchemeID)
+	require.True(t, ok)
+	other, err := CryptoFor(short).ThresholdScheme.Recover(pub, msg, partials, thr, n)
+	require.Error(t, err)
+	require.Nil(t, other)
+	require.Error(t, CryptoFor(short).ThresholdScheme.VerifyRecovered(pub.Commit(), msg, sig))
+}
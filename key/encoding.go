@@ -28,6 +28,16 @@ func StringToPoint(g kyber.Group, s string) (kyber.Point, error) {
 	return p, p.UnmarshalBinary(buff)
 }
 
+// stringToKeyPoint unmarshals a key point from the given string, in the group
+// given by its length.
+func stringToKeyPoint(s string) (kyber.Point, error) {
+	buff, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return UnmarshalKeyPoint(buff)
+}
+
 // StringToScalar unmarshals a scalar in the given group from the given string.
 func StringToScalar(g kyber.Group, s string) (kyber.Scalar, error) {
 	buff, err := hex.DecodeString(s)
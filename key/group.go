@@ -55,6 +55,10 @@ type Group struct {
 	// The distributed public key of this group. It is nil if the group has not
 	// ran a DKG protocol yet.
 	PublicKey *DistPublic
+	// GossipFanout is the number of peers each node relays the partial
+	// signatures to. When it is 0, each node sends its partial directly to
+	// every other node.
+	GossipFanout int
 }
 
 // Find returns the Node that is equal to the given identity (without the
@@ -198,6 +202,7 @@ type GroupTOML struct {
 	PublicKey      *DistPublicTOML `toml:",omitempty"`
 	SchemeID       string
 	ID             string
+	GossipFanout   int `toml:",omitempty"`
 }
 
 // FromTOML decodes the group from the toml struct
@@ -231,6 +236,9 @@ func (g *Group) FromTOML(i interface{}) (err error) {
 		if err = g.PublicKey.FromTOML(gt.PublicKey); err != nil {
 			return fmt.Errorf("group: unwrapping distributed public key: %w", err)
 		}
+		if !g.PublicKey.OfScheme(g.Scheme) {
+			return fmt.Errorf("group: distributed public key not in the key group of scheme %s", g.Scheme.ID)
+		}
 	}
 	g.Period, err = time.ParseDuration(gt.Period)
 	if err != nil {
@@ -254,6 +262,11 @@ func (g *Group) FromTOML(i interface{}) (err error) {
 		}
 	}
 
+	if gt.GossipFanout < 0 {
+		return errors.New("group file has a negative gossip fanout")
+	}
+	g.GossipFanout = gt.GossipFanout
+
 	// for backward compatibility we make sure to write "default" as beacon id if not set
 	g.ID = commonutils.GetCanonicalBeaconID(gt.ID)
 
@@ -283,6 +296,7 @@ func (g *Group) TOML() interface{} {
 		gtoml.TransitionTime = g.TransitionTime
 	}
 	gtoml.GenesisSeed = hex.EncodeToString(g.GetGenesisSeed())
+	gtoml.GossipFanout = g.GossipFanout
 	return gtoml
 }
 
@@ -395,8 +409,8 @@ func GroupFromProto(g *proto.GroupPacket) (*Group, error) {
 
 	var dist = new(DistPublic)
 	for _, coeff := range g.DistKey {
-		c := KeyGroup.Point()
-		if err := c.UnmarshalBinary(coeff); err != nil {
+		c, err := UnmarshalKeyPoint(coeff)
+		if err != nil {
 			return nil, fmt.Errorf("invalid distributed key coefficients:%w", err)
 		}
 		dist.Coefficients = append(dist.Coefficients, c)
@@ -411,6 +425,7 @@ func GroupFromProto(g *proto.GroupPacket) (*Group, error) {
 		TransitionTime: int64(g.GetTransitionTime()),
 		Scheme:         sch,
 		ID:             beaconID,
+		GossipFanout:   int(g.GetGossipFanout()),
 	}
 
 	if g.GetGenesisSeed() != nil {
@@ -421,6 +436,9 @@ func GroupFromProto(g *proto.GroupPacket) (*Group, error) {
 		if len(dist.Coefficients) != group.Threshold {
 			return nil, fmt.Errorf("public coefficient length %d is not equal to threshold %d", len(dist.Coefficients), group.Threshold)
 		}
+		if !dist.OfScheme(sch) {
+			return nil, fmt.Errorf("distributed key not in the key group of scheme %s", sch.ID)
+		}
 		group.PublicKey = dist
 	}
 
@@ -453,6 +471,7 @@ func (g *Group) ToProto(version commonutils.Version) *proto.GroupPacket {
 	out.TransitionTime = uint64(g.TransitionTime)
 	out.GenesisSeed = g.GetGenesisSeed()
 	out.SchemeID = g.Scheme.ID
+	out.GossipFanout = uint32(g.GossipFanout)
 
 	out.Metadata = common.NewMetadata(version.ToProto())
 	out.Metadata.BeaconID = commonutils.GetCanonicalBeaconID(g.ID)
@@ -38,7 +38,7 @@ func TestGroupProtobuf(t *testing.T) {
 	ids := newIds(n)
 	sch := scheme.GetSchemeFromEnv()
 
-	dpub := []kyber.Point{KeyGroup.Point().Pick(random.New())}
+	dpub := []kyber.Point{CryptoFor(sch).KeyGroup.Point().Pick(random.New())}
 	group := LoadGroup(ids, 1, &DistPublic{dpub}, 30*time.Second, 61, sch, "test_beacon")
 	group.Threshold = thr
 	group.Period = time.Second * 4
@@ -55,10 +55,11 @@ func TestGroupProtobuf(t *testing.T) {
 
 	var dpub2 []kyber.Point
 	for i := 0; i < thr; i++ {
-		dpub2 = append(dpub2, KeyGroup.Point().Pick(random.New()))
+		dpub2 = append(dpub2, CryptoFor(sch).KeyGroup.Point().Pick(random.New()))
 	}
 	group2 := *group
 	group2.PublicKey = &DistPublic{dpub2}
+	group2.GossipFanout = 3
 	vectors = append(vectors, testVector{
 		group:  &group2,
 		change: nil,
@@ -100,6 +101,7 @@ func TestGroupProtobuf(t *testing.T) {
 		require.Equal(t, seed, loaded.GetGenesisSeed())
 		require.Equal(t, genesis, loaded.GenesisTime)
 		require.Equal(t, transition, loaded.TransitionTime)
+		require.Equal(t, tv.group.GossipFanout, loaded.GossipFanout)
 		require.Equal(t, tv.group.Hash(), loaded.Hash())
 	}
 }
@@ -108,7 +110,7 @@ func TestGroupUnsignedIdentities(t *testing.T) {
 	ids := newIds(5)
 	sch := scheme.GetSchemeFromEnv()
 
-	group := LoadGroup(ids, 1, &DistPublic{[]kyber.Point{KeyGroup.Point()}}, 30*time.Second, 61, sch, "test_beacon")
+	group := LoadGroup(ids, 1, &DistPublic{[]kyber.Point{CryptoFor(sch).KeyGroup.Point()}}, 30*time.Second, 61, sch, "test_beacon")
 	require.Nil(t, group.UnsignedIdentities())
 
 	ids[0].Signature = nil
@@ -120,14 +122,15 @@ func TestGroupUnsignedIdentities(t *testing.T) {
 func TestGroupSaveLoad(t *testing.T) {
 	n := 3
 	ids := newIds(n)
-	dpub := []kyber.Point{KeyGroup.Point().Pick(random.New())}
 	sch := scheme.GetSchemeFromEnv()
+	dpub := []kyber.Point{CryptoFor(sch).KeyGroup.Point().Pick(random.New())}
 
 	group := LoadGroup(ids, 1, &DistPublic{dpub}, 30*time.Second, 61, sch, "test_beacon")
 	group.Threshold = 3
 	group.Period = time.Second * 4
 	group.GenesisTime = time.Now().Add(10 * time.Second).Unix()
 	group.TransitionTime = time.Now().Add(10 * time.Second).Unix()
+	group.GossipFanout = 2
 
 	genesis := group.GenesisTime
 	transition := group.TransitionTime
@@ -155,6 +158,7 @@ func TestGroupSaveLoad(t *testing.T) {
 	require.Equal(t, seed, loaded.GetGenesisSeed())
 	require.Equal(t, genesis, loaded.GenesisTime)
 	require.Equal(t, transition, loaded.TransitionTime)
+	require.Equal(t, group.GossipFanout, loaded.GossipFanout)
 
 	require.Equal(t, group.Hash(), loaded.Hash())
 }
@@ -163,8 +167,8 @@ func TestGroupSaveLoad(t *testing.T) {
 func makeGroup(t *testing.T) *Group {
 	t.Helper()
 
-	fakeKey := KeyGroup.Point().Pick(random.New())
 	sch := scheme.GetSchemeFromEnv()
+	fakeKey := CryptoFor(sch).KeyGroup.Point().Pick(random.New())
 
 	group := LoadGroup([]*Node{}, 1, &DistPublic{Coefficients: []kyber.Point{fakeKey}}, 30*time.Second, 0, sch, "test_beacon")
 	group.Threshold = MinimumT(0)
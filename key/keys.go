@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net"
 
+	"github.com/drand/drand/common/scheme"
 	proto "github.com/drand/drand/protobuf/drand"
 	"github.com/drand/kyber"
 	"github.com/drand/kyber/share"
@@ -58,7 +59,7 @@ func (i *Identity) Hash() []byte {
 // correct or not
 func (i *Identity) ValidSignature() error {
 	msg := i.Hash()
-	return AuthScheme.Verify(i.Key, msg, i.Signature)
+	return CryptoOfKey(i.Key).AuthScheme.Verify(i.Key, msg, i.Signature)
 }
 
 // Equal indicates if two identities are equal
@@ -78,15 +79,25 @@ func (i *Identity) Equal(i2 *Identity) bool {
 // SelfSign signs the public key with the key pair
 func (p *Pair) SelfSign() {
 	msg := p.Public.Hash()
-	signature, _ := AuthScheme.Sign(p.Key, msg)
+	signature, _ := CryptoOfKey(p.Public.Key).AuthScheme.Sign(p.Key, msg)
 	p.Public.Signature = signature
 }
 
 // NewKeyPair returns a freshly created private / public key pair. The group is
 // decided by the group variable by default.
 func NewKeyPair(address string) *Pair {
-	key := KeyGroup.Scalar().Pick(random.New())
-	pubKey := KeyGroup.Point().Mul(key, nil)
+	return newKeyPair(address, defaultCrypto)
+}
+
+// NewKeyPairWithScheme returns a freshly created private / public key pair
+// whose public key lives in the key group of the given scheme.
+func NewKeyPairWithScheme(address string, sch scheme.Scheme) *Pair {
+	return newKeyPair(address, CryptoFor(sch))
+}
+
+func newKeyPair(address string, c *Crypto) *Pair {
+	key := c.KeyGroup.Scalar().Pick(random.New())
+	pubKey := c.KeyGroup.Point().Mul(key, nil)
 	pub := &Identity{
 		Key:  pubKey,
 		Addr: address,
@@ -152,7 +163,7 @@ func (i *Identity) FromTOML(t interface{}) error {
 		return errors.New("public can't decode from non PublicTOML struct")
 	}
 	var err error
-	i.Key, err = StringToPoint(KeyGroup, ptoml.Key)
+	i.Key, err = stringToKeyPoint(ptoml.Key)
 	if err != nil {
 		return fmt.Errorf("decoding public key: %w", err)
 	}
@@ -204,8 +215,8 @@ func IdentityFromProto(n *proto.Identity) (*Identity, error) {
 	if err != nil {
 		return nil, err
 	}
-	public := KeyGroup.Point()
-	if err := public.UnmarshalBinary(n.GetKey()); err != nil {
+	public, err := UnmarshalKeyPoint(n.GetKey())
+	if err != nil {
 		return nil, err
 	}
 
@@ -236,7 +247,7 @@ type Share dkg.DistKeyShare
 // PubPoly returns the public polynomial that can be used to verify any
 // individual patial signature
 func (s *Share) PubPoly() *share.PubPoly {
-	return share.NewPubPoly(KeyGroup, KeyGroup.Point().Base(), s.Commits)
+	return newPubPoly(s.Commits)
 }
 
 // PrivateShare returns the private share used to produce a partial signature
@@ -270,7 +281,7 @@ func (s *Share) FromTOML(i interface{}) error {
 	}
 	s.Commits = make([]kyber.Point, len(t.Commits))
 	for i, c := range t.Commits {
-		p, err := StringToPoint(KeyGroup, c)
+		p, err := stringToKeyPoint(c)
 		if err != nil {
 			return fmt.Errorf("share.Commit[%d] corruputed: %w", i, err)
 		}
@@ -313,7 +324,7 @@ type DistPublic struct {
 
 // PubPoly provides the public polynomial commitment
 func (d *DistPublic) PubPoly() *share.PubPoly {
-	return share.NewPubPoly(KeyGroup, KeyGroup.Point().Base(), d.Coefficients)
+	return newPubPoly(d.Coefficients)
 }
 
 // Key returns the first coefficient as representing the public key to be used
@@ -322,6 +333,17 @@ func (d *DistPublic) Key() kyber.Point {
 	return d.Coefficients[0]
 }
 
+// OfScheme returns true if the distributed key lives in the key group of the
+// given scheme
+func (d *DistPublic) OfScheme(sch scheme.Scheme) bool {
+	for _, c := range d.Coefficients {
+		if !KeyOfScheme(c, sch) {
+			return false
+		}
+	}
+	return true
+}
+
 // Hash computes the hash of this distributed key.
 func (d *DistPublic) Hash() []byte {
 	h := hashFunc()
@@ -355,7 +377,7 @@ func (d *DistPublic) FromTOML(i interface{}) error {
 	points := make([]kyber.Point, len(dtoml.Coefficients))
 	var err error
 	for i, s := range dtoml.Coefficients {
-		points[i], err = StringToPoint(KeyGroup, s)
+		points[i], err = stringToKeyPoint(s)
 		if err != nil {
 			return err
 		}
@@ -385,6 +407,16 @@ func (d *DistPublic) Equal(d2 *DistPublic) bool {
 	return true
 }
 
+// newPubPoly returns the public polynomial of the given commitments, in the
+// group they live in
+func newPubPoly(commits []kyber.Point) *share.PubPoly {
+	g := KeyGroup
+	if len(commits) > 0 {
+		g = CryptoOfKey(commits[0]).KeyGroup
+	}
+	return share.NewPubPoly(g, g.Point().Base(), commits)
+}
+
 // DefaultThreshold return floor(n / 2) + 1
 func DefaultThreshold(n int) int {
 	return MinimumT(n)
@@ -10,6 +10,7 @@ import (
 	"github.com/BurntSushi/toml"
 	"github.com/stretchr/testify/require"
 
+	"github.com/drand/drand/common/scheme"
 	"github.com/drand/kyber"
 	"github.com/drand/kyber/share"
 	"github.com/drand/kyber/util/random"
@@ -131,6 +132,46 @@ func TestShare(t *testing.T) {
 	}
 }
 
+func TestKeysShortSigScheme(t *testing.T) {
+	sch, ok := scheme.GetSchemeByID(scheme.ShortSigSchemeID)
+	require.True(t, ok)
+	c := CryptoFor(sch)
+
+	kp := NewKeyPairWithScheme(testAddr, sch)
+	require.Equal(t, c.KeyGroup.PointLen(), kp.Public.Key.MarshalSize())
+	require.Equal(t, c, CryptoOfKey(kp.Public.Key))
+	require.NoError(t, kp.Public.ValidSignature())
+
+	id := new(Identity)
+	require.NoError(t, id.FromTOML(kp.Public.TOML()))
+	require.True(t, kp.Public.Key.Equal(id.Key))
+	require.NoError(t, id.ValidSignature())
+
+	decodedID, err := IdentityFromProto(kp.Public.ToProto())
+	require.NoError(t, err)
+	require.True(t, kp.Public.Key.Equal(decodedID.Key))
+	require.NoError(t, decodedID.ValidSignature())
+
+	// a share on G2 keeps its commitments on G2 and verifies partials on G1
+	priv := share.NewPriPoly(c.KeyGroup, 3, nil, random.New())
+	s := &Share{Share: priv.Shares(5)[2]}
+	_, s.Commits = priv.Commit(nil).Info()
+	s2 := new(Share)
+	require.NoError(t, s2.FromTOML(s.TOML()))
+	require.True(t, s2.Public().OfScheme(sch))
+	require.False(t, s2.Public().OfScheme(scheme.Scheme{}))
+
+	msg := []byte("the message of the round")
+	partial, err := c.ThresholdScheme.Sign(s2.PrivateShare(), msg)
+	require.NoError(t, err)
+	require.Len(t, partial, 2+48)
+	require.NoError(t, c.ThresholdScheme.VerifyPartial(s2.PubPoly(), msg, partial))
+
+	dist := new(DistPublic)
+	require.NoError(t, dist.FromTOML(s2.Public().TOML()))
+	require.True(t, dist.Equal(s2.Public()))
+}
+
 func BatchIdentities(n int) ([]*Pair, *Group) {
 	startPort := 8000
 	startAddr := "127.0.0.1:"
@@ -0,0 +1,185 @@
+package key
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/drand/drand/common/scheme"
+	"github.com/drand/drand/internal/schemes"
+	"github.com/drand/kyber"
+	"github.com/drand/kyber/sign"
+	//nolint:staticcheck
+	bls "github.com/drand/kyber/sign/bls"
+	"github.com/drand/kyber/sign/schnorr"
+	"github.com/drand/kyber/sign/tbls"
+)
+
+// Crypto gathers the groups and signature schemes of a beacon scheme: the keys
+// live in KeyGroup and the signatures in SigGroup, which are G1 and G2 by
+// default, or the other way around for the schemes with signatures on G1.
+type Crypto struct {
+	// KeyGroup is the group of the keys, public shares and distributed key
+	KeyGroup kyber.Group
+	// SigGroup is the group of the signatures
+	SigGroup kyber.Group
+	// ThresholdScheme signs the partials and verifies the beacons
+	ThresholdScheme sign.ThresholdScheme
+	// AuthScheme signs the public identities of the nodes
+	AuthScheme sign.Scheme
+	// DKGAuthScheme authenticates the packets of the DKG
+	DKGAuthScheme sign.Scheme
+	// Digest returns the message signed at a round
+	Digest DigestFunc
+	// Chained is set when the beacons sign the signature of the previous one,
+	// which they must then carry and link to
+	Chained bool
+
+	sigsOnG1 bool
+	// dst is the domain separation tag used to hash to SigGroup, nil when the
+	// curve library hashes with its own
+	dst []byte
+}
+
+var defaultCrypto = &Crypto{
+	KeyGroup:        KeyGroup,
+	SigGroup:        SigGroup,
+	ThresholdScheme: Scheme,
+	AuthScheme:      AuthScheme,
+	DKGAuthScheme:   DKGAuthScheme,
+}
+
+var sigsOnG1Crypto = &Crypto{
+	KeyGroup:        Pairing.G2(),
+	SigGroup:        Pairing.G1(),
+	ThresholdScheme: tbls.NewThresholdSchemeOnG1(Pairing),
+	AuthScheme:      bls.NewSchemeOnG1(Pairing),
+	DKGAuthScheme:   schnorr.NewScheme(&schnorrSuite{Pairing.G2()}),
+	sigsOnG1:        true,
+}
+
+// cryptos holds the groups and signature schemes of the registered schemes, by
+// scheme ID
+var cryptos = struct {
+	sync.RWMutex
+	m map[string]*Crypto
+}{m: make(map[string]*Crypto)}
+
+func init() {
+	chained := NewCrypto(false, "", ChainedDigest)
+	chained.Chained = true
+	builtins := map[string]*Crypto{
+		scheme.DefaultSchemeID:   chained,
+		scheme.UnchainedSchemeID: NewCrypto(false, "", UnchainedDigest),
+		scheme.ShortSigSchemeID:  NewCrypto(true, "", UnchainedDigest),
+		scheme.RFC9380SchemeID:   NewCrypto(true, scheme.DSTG1, UnchainedDigest),
+	}
+	for id, c := range builtins {
+		cryptos.m[id] = c
+	}
+}
+
+// RegisterScheme registers the scheme, and the groups and signature schemes it
+// uses, making it available to the nodes and clients by its ID. It is the only
+// way to register a scheme, so that every scheme found by ID has them.
+func RegisterScheme(sch scheme.Scheme, c *Crypto) error {
+	if c == nil || c.Digest == nil {
+		return fmt.Errorf("scheme [%s] needs its groups, signature schemes and digest", sch.ID)
+	}
+	if c.Chained == sch.DecouplePrevSig {
+		return fmt.Errorf("scheme [%s] must decouple the previous signature exactly when its crypto isn't chained", sch.ID)
+	}
+	// the scheme can't be looked up before its crypto is there
+	cryptos.Lock()
+	defer cryptos.Unlock()
+	if err := schemes.Register(sch.ID, sch); err != nil {
+		return err
+	}
+	cryptos.m[sch.ID] = c
+	return nil
+}
+
+// CryptoFor returns the groups and signature schemes of the given scheme, those
+// of the default scheme if its ID is empty. It panics if the scheme wasn't
+// registered, which can't happen for a scheme found by scheme.GetSchemeByID.
+func CryptoFor(sch scheme.Scheme) *Crypto {
+	id := sch.ID
+	if id == "" {
+		id = scheme.DefaultSchemeID
+	}
+
+	cryptos.RLock()
+	defer cryptos.RUnlock()
+	c, ok := cryptos.m[id]
+	if !ok {
+		panic(fmt.Sprintf("no groups and signature schemes registered for scheme [%s]", sch.ID))
+	}
+	return c
+}
+
+// NewCrypto returns the groups and signature schemes of a scheme with keys on
+// G1 and signatures on G2, or the other way around if sigsOnG1 is set. The
+// messages are hashed to the signature group with the given domain separation
+// tag, or with the one of the curve library if it's empty, and digested before
+// with digest.
+func NewCrypto(sigsOnG1 bool, dst string, digest DigestFunc) *Crypto {
+	c := *defaultCrypto
+	if sigsOnG1 {
+		c = *sigsOnG1Crypto
+	}
+	c.Digest = digest
+	if dst != "" {
+		c.dst = []byte(dst)
+		c.ThresholdScheme = &dstScheme{c: &c}
+	}
+	return &c
+}
+
+// CryptoOfKey returns the groups and signature schemes of the built-in scheme
+// whose keys live in the group of the given point, hashing with the tag of the
+// curve library
+func CryptoOfKey(p kyber.Point) *Crypto {
+	id := scheme.DefaultSchemeID
+	if p != nil && p.MarshalSize() == sigsOnG1Crypto.KeyGroup.PointLen() {
+		id = scheme.ShortSigSchemeID
+	}
+	return CryptoFor(scheme.Scheme{ID: id})
+}
+
+// KeyOfScheme returns true if the point lives in the key group of the scheme
+func KeyOfScheme(p kyber.Point, sch scheme.Scheme) bool {
+	return p != nil && p.MarshalSize() == CryptoFor(sch).KeyGroup.PointLen()
+}
+
+// keyGroupOf returns the key group of the encoded point, telling G1 and G2
+// apart by their length: the key material doesn't record the scheme it belongs
+// to.
+func keyGroupOf(buff []byte) kyber.Group {
+	if len(buff) == sigsOnG1Crypto.KeyGroup.PointLen() {
+		return sigsOnG1Crypto.KeyGroup
+	}
+	return defaultCrypto.KeyGroup
+}
+
+// UnmarshalKeyPoint decodes a key point, in G1 or G2 depending on its length
+func UnmarshalKeyPoint(buff []byte) (kyber.Point, error) {
+	p := keyGroupOf(buff).Point()
+	return p, p.UnmarshalBinary(buff)
+}
+
+// validatePairing checks that e(pub, H(m)) == e(base, sig), whichever group the
+// keys are on
+func (c *Crypto) validatePairing(pub, hm, sig kyber.Point) bool {
+	if c.sigsOnG1 {
+		return Pairing.ValidatePairing(hm, pub, sig, c.KeyGroup.Point().Base())
+	}
+	return Pairing.ValidatePairing(pub, hm, c.KeyGroup.Point().Base(), sig)
+}
+
+// Pair computes the pairing of a point of the key group with a point of the
+// signature group, whichever of G1 and G2 they are
+func (c *Crypto) Pair(k, sig kyber.Point) kyber.Point {
+	if c.sigsOnG1 {
+		return Pairing.Pair(sig, k)
+	}
+	return Pairing.Pair(k, sig)
+}
@@ -0,0 +1,61 @@
+package key
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/common/scheme"
+	"github.com/drand/kyber/share"
+	"github.com/drand/kyber/util/random"
+)
+
+func TestRegisterScheme(t *testing.T) {
+	for _, id := range scheme.ListSchemes() {
+		sch, ok := scheme.GetSchemeByID(id)
+		require.True(t, ok)
+		require.NotNil(t, CryptoFor(sch).Digest, id)
+		require.Equal(t, !sch.DecouplePrevSig, CryptoFor(sch).Chained, id)
+	}
+
+	sch := scheme.Scheme{ID: "test-experimental-scheme", DecouplePrevSig: true}
+	require.Panics(t, func() { CryptoFor(sch) })
+	require.Error(t, RegisterScheme(sch, nil))
+	require.Error(t, RegisterScheme(sch, &Crypto{}))
+	_, ok := scheme.GetSchemeByID(sch.ID)
+	require.False(t, ok)
+
+	c := NewCrypto(true, "TEST-EXPERIMENTAL-SCHEME-DST", UnchainedDigest)
+	// the scheme and its crypto must agree on the linkage of the beacons
+	require.Error(t, RegisterScheme(scheme.Scheme{ID: sch.ID}, c))
+	require.NoError(t, RegisterScheme(sch, c))
+	require.Error(t, RegisterScheme(sch, c))
+	def, err := scheme.GetSchemeByIDWithDefault("")
+	require.NoError(t, err)
+	require.Error(t, RegisterScheme(def, c))
+
+	found, ok := scheme.GetSchemeByID(sch.ID)
+	require.True(t, ok)
+	require.Equal(t, sch, found)
+	ids := scheme.ListSchemes()
+	require.Equal(t, sch.ID, ids[len(ids)-1])
+	require.Same(t, c, CryptoFor(found))
+
+	// the scheme signs with its own tag
+	secret := c.KeyGroup.Scalar().Pick(random.New())
+	public := c.KeyGroup.Point().Mul(secret, nil)
+	msg := c.Digest(1, nil)
+	tsig, err := c.ThresholdScheme.Sign(&share.PriShare{V: secret}, msg)
+	require.NoError(t, err)
+	require.NoError(t, c.ThresholdScheme.VerifyRecovered(public, msg, tsig[2:]))
+	short, _ := scheme.GetSchemeByID(scheme.ShortSigSchemeID)
+	require.Error(t, CryptoFor(short).ThresholdScheme.VerifyRecovered(public, msg, tsig[2:]))
+}
+
+func TestDigest(t *testing.T) {
+	prev := []byte("the signature of the previous round")
+	require.Equal(t, UnchainedDigest(3, nil), UnchainedDigest(3, prev))
+	require.NotEqual(t, UnchainedDigest(3, nil), UnchainedDigest(4, nil))
+	require.NotEqual(t, ChainedDigest(3, nil), ChainedDigest(3, prev))
+	require.Equal(t, ChainedDigest(3, nil), UnchainedDigest(3, nil))
+}
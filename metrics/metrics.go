@@ -85,12 +85,67 @@ var (
 		Help: "Discrepancy between beacon creation time and calculated round time",
 	}, []string{"beacon_id"})
 
+	// PartialArrivalLatency (Group) millisecond duration between the time of a
+	// round and the arrival of the partial signature of each group member.
+	PartialArrivalLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "partial_arrival_latency",
+		Help:    "Arrival time of the partial signatures of each peer relative to the time of their round, in ms",
+		Buckets: prometheus.ExponentialBuckets(10, 2, 12),
+	}, []string{"beacon_id", "peer"})
+
+	// PartialMissed (Group) counts the rounds for which a group member didn't
+	// send its partial signature in time.
+	PartialMissed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "partial_missed",
+		Help: "Number of rounds a peer didn't send its partial signature for",
+	}, []string{"beacon_id", "peer"})
+
+	// PeerReputation (Group) is the reputation score of each peer, lowered by
+	// the invalid data, timeouts and out of round replies it sends.
+	PeerReputation = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "peer_reputation",
+		Help: "Reputation score of each peer",
+	}, []string{"beacon_id", "peer"})
+
+	// PeerOffenses (Group) counts the offenses of each peer by kind.
+	PeerOffenses = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "peer_offenses",
+		Help: "Number of invalid data, timeouts and out of round replies of each peer",
+	}, []string{"beacon_id", "peer", "offense"})
+
+	// PeerBanned (Group) is 1 while a peer is banned for its low reputation.
+	PeerBanned = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "peer_banned",
+		Help: "Whether a peer is banned for its low reputation",
+	}, []string{"beacon_id", "peer"})
+
 	// LastBeaconRound is the most recent round (as also seen at /health) stored.
 	LastBeaconRound = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "last_beacon_round",
 		Help: "Last locally stored beacon",
 	}, []string{"beacon_id"})
 
+	// ChainMissingRounds (Group) is the number of rounds missing from the
+	// database, as last seen by the chain auditor.
+	ChainMissingRounds = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "chain_missing_rounds",
+		Help: "Number of rounds missing between the first and last stored beacons",
+	}, []string{"beacon_id"})
+
+	// ChainInvalidRounds (Group) is the number of rounds stored with an invalid
+	// signature, as last seen by the chain auditor.
+	ChainInvalidRounds = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "chain_invalid_rounds",
+		Help: "Number of stored beacons with an invalid signature",
+	}, []string{"beacon_id"})
+
+	// ChainRepairedRounds (Group) counts the rounds the chain auditor fetched
+	// again from the group.
+	ChainRepairedRounds = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "chain_repaired_rounds",
+		Help: "Number of missing or invalid beacons fetched again from the group",
+	}, []string{"beacon_id"})
+
 	// HTTPCallCounter (HTTP) how many http requests
 	HTTPCallCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
 		Name: "http_call_counter",
@@ -271,7 +326,15 @@ func bindMetrics() {
 		GroupSize,
 		GroupThreshold,
 		BeaconDiscrepancyLatency,
+		PartialArrivalLatency,
+		PartialMissed,
+		PeerReputation,
+		PeerOffenses,
+		PeerBanned,
 		LastBeaconRound,
+		ChainMissingRounds,
+		ChainInvalidRounds,
+		ChainRepairedRounds,
 		drandBuildTime,
 		dkgState,
 		dkgStateTimestamp,
@@ -207,6 +207,10 @@ func (g *grpcClient) PartialBeacon(ctx context.Context, p Peer, in *drand.Partia
 // MaxSyncBuffer is the maximum number of queued rounds when syncing
 const MaxSyncBuffer = 500
 
+// SyncAcceptedHeader is the header a node sends when it accepts to serve a
+// sync request
+const SyncAcceptedHeader = "drand-sync-accepted"
+
 func (g *grpcClient) SyncChain(ctx context.Context, p Peer, in *drand.SyncRequest, opts ...CallOption) (chan *drand.BeaconPacket, error) {
 	resp := make(chan *drand.BeaconPacket, MaxSyncBuffer)
 	c, err := g.conn(p)
@@ -218,6 +222,25 @@ func (g *grpcClient) SyncChain(ctx context.Context, p Peer, in *drand.SyncReques
 	if err != nil {
 		return nil, err
 	}
+	// the peer tells it accepts the stream in its headers. Otherwise, either
+	// it rejected the stream, e.g. because it is busy, or it is an older node
+	// that streams the beacons right away
+	md, err := stream.Header()
+	if err != nil {
+		return nil, err
+	}
+	var first *drand.BeaconPacket
+	if len(md.Get(SyncAcceptedHeader)) == 0 {
+		first, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			close(resp)
+			return resp, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		resp <- first
+	}
 	go func() {
 		defer close(resp)
 		for {
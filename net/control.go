@@ -1,9 +1,13 @@
 package net
 
 import (
+	"bytes"
 	ctx "context"
+	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
 	"net"
 	"strings"
 	"time"
@@ -147,12 +151,14 @@ func (c *ControlClient) ListSchemes() (*control.ListSchemesResponse, error) {
 	return resp, err
 }
 
-// InitReshareLeader sets up the node to be ready for a resharing protocol.
+// InitReshareLeader sets up the node to be ready for a resharing protocol. A
+// negative catchup period or gossip fanout keeps the one of the old group.
 // NOTE: only group referral via filesystem path is supported at the moment.
 // XXX Might be best to move to core/
 func (c *ControlClient) InitReshareLeader(
 	nodes, threshold int,
 	timeout, catchupPeriod time.Duration,
+	gossipFanout int,
 	secret, oldPath string,
 	offset int,
 	beaconID string) (*control.GroupPacket, error) {
@@ -173,6 +179,8 @@ func (c *ControlClient) InitReshareLeader(
 		},
 		CatchupPeriodChanged: catchupPeriod >= 0,
 		CatchupPeriod:        uint32(catchupPeriod.Seconds()),
+		GossipFanoutChanged:  gossipFanout >= 0,
+		GossipFanout:         uint32(gossipFanout),
 		Metadata:             &metadata,
 	}
 
@@ -208,6 +216,7 @@ func (c *ControlClient) InitReshare(leader Peer, secret, oldPath string, force b
 func (c *ControlClient) InitDKGLeader(
 	nodes, threshold int,
 	beaconPeriod, catchupPeriod, timeout time.Duration,
+	gossipFanout int,
 	entropy *control.EntropyInfo,
 	secret string,
 	offset int,
@@ -228,6 +237,7 @@ func (c *ControlClient) InitDKGLeader(
 		Entropy:       entropy,
 		BeaconPeriod:  uint32(beaconPeriod.Seconds()),
 		CatchupPeriod: uint32(catchupPeriod.Seconds()),
+		GossipFanout:  uint32(gossipFanout),
 		SchemeID:      schemeID,
 		Metadata:      &metadata,
 	}
@@ -369,6 +379,37 @@ func (c *ControlClient) StartFollowChain(cc ctx.Context,
 	upTo uint64,
 	beaconID string) (outCh chan *control.SyncProgress,
 	errCh chan error, e error) {
+	log.DefaultLogger().Infow("Launching a follow request", "nodes", nodes, "tls", tls, "upTo", upTo, "hash", hashStr, "beaconID", beaconID)
+	return c.startFollowChain(cc, hashStr, &control.StartSyncRequest{
+		Nodes: nodes,
+		IsTls: tls,
+		UpTo:  upTo,
+	}, beaconID)
+}
+
+// StartFollowChainFromRelays initiates the client catching up on an existing
+// chain from http relays or public gRPC endpoints, rather than from the nodes
+// of the chain
+func (c *ControlClient) StartFollowChainFromRelays(cc ctx.Context,
+	hashStr string,
+	relays []string,
+	tls bool,
+	upTo uint64,
+	beaconID string) (outCh chan *control.SyncProgress,
+	errCh chan error, e error) {
+	log.DefaultLogger().Infow("Launching a follow request", "relays", relays, "tls", tls, "upTo", upTo, "hash", hashStr, "beaconID", beaconID)
+	return c.startFollowChain(cc, hashStr, &control.StartSyncRequest{
+		Relays: relays,
+		IsTls:  tls,
+		UpTo:   upTo,
+	}, beaconID)
+}
+
+func (c *ControlClient) startFollowChain(cc ctx.Context,
+	hashStr string,
+	req *control.StartSyncRequest,
+	beaconID string) (outCh chan *control.SyncProgress,
+	errCh chan error, e error) {
 	// we need to make sure the beaconID is set and also the chain hash to check integrity of the chain info
 	metadata := protoCommon.NewMetadata(c.version.ToProto())
 	if beaconID == "" {
@@ -385,13 +426,8 @@ func (c *ControlClient) StartFollowChain(cc ctx.Context,
 		return nil, nil, err
 	}
 	metadata.ChainHash = hash
-	log.DefaultLogger().Infow("Launching a follow request", "nodes", nodes, "tls", tls, "upTo", upTo, "hash", hashStr, "beaconID", beaconID)
-	stream, err := c.client.StartFollowChain(cc, &control.StartSyncRequest{
-		Nodes:    nodes,
-		IsTls:    tls,
-		UpTo:     upTo,
-		Metadata: metadata,
-	})
+	req.Metadata = metadata
+	stream, err := c.client.StartFollowChain(cc, req)
 	if err != nil {
 		log.DefaultLogger().Errorw("Error while following chain", "err", err)
 		return nil, nil, err
@@ -420,6 +456,21 @@ func (c *ControlClient) StartFollowChain(cc ctx.Context,
 	return outCh, errCh, nil
 }
 
+// PauseBeacon stops the node from sending its partial signatures for the beacon
+func (c *ControlClient) PauseBeacon(beaconID string) error {
+	metadata := protoCommon.Metadata{NodeVersion: c.version.ToProto(), BeaconID: beaconID}
+	_, err := c.client.PauseBeacon(ctx.Background(), &control.PauseBeaconRequest{Metadata: &metadata})
+	return err
+}
+
+// ResumeBeacon makes the node catch up and send its partial signatures again
+// for the beacon
+func (c *ControlClient) ResumeBeacon(beaconID string) error {
+	metadata := protoCommon.Metadata{NodeVersion: c.version.ToProto(), BeaconID: beaconID}
+	_, err := c.client.ResumeBeacon(ctx.Background(), &control.ResumeBeaconRequest{Metadata: &metadata})
+	return err
+}
+
 // BackupDB backs up the database to a file
 func (c *ControlClient) BackupDB(outFile, beaconID string) error {
 	metadata := protoCommon.Metadata{NodeVersion: c.version.ToProto(), BeaconID: beaconID}
@@ -427,6 +478,68 @@ func (c *ControlClient) BackupDB(outFile, beaconID string) error {
 	return err
 }
 
+// StreamBackup writes to w a backup of the database of the beacon sent by the
+// daemon, and checks it against the checksum the daemon sends last. It returns
+// the size of the backup.
+func (c *ControlClient) StreamBackup(cc ctx.Context, beaconID string, w io.Writer) (int64, error) {
+	metadata := protoCommon.Metadata{NodeVersion: c.version.ToProto(), BeaconID: beaconID}
+	stream, err := c.client.StreamBackup(cc, &control.StreamBackupRequest{Metadata: &metadata})
+	if err != nil {
+		return 0, err
+	}
+
+	h := sha256.New()
+	var size int64
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return size, errors.New("backup stream ended without a checksum")
+		}
+		if err != nil {
+			return size, err
+		}
+
+		if sum := chunk.GetChecksum(); len(sum) > 0 {
+			if !bytes.Equal(sum, h.Sum(nil)) {
+				return size, errors.New("checksum of the backup received doesn't match the one sent by the daemon")
+			}
+			return size, nil
+		}
+
+		n, err := w.Write(chunk.GetData())
+		size += int64(n)
+		if err != nil {
+			return size, err
+		}
+		h.Write(chunk.GetData())
+	}
+}
+
+// RestoreDB replaces the database of the beacon with the backup in inFile,
+// it returns the number of beacons restored.
+func (c *ControlClient) RestoreDB(inFile, beaconID string) (uint64, error) {
+	metadata := protoCommon.Metadata{NodeVersion: c.version.ToProto(), BeaconID: beaconID}
+	resp, err := c.client.RestoreDatabase(ctx.Background(), &control.RestoreDBRequest{InputFile: inFile, Metadata: &metadata})
+	if err != nil {
+		return 0, err
+	}
+	return resp.GetRestored(), nil
+}
+
+// MigrateDB asks the daemon to start rewriting the beacons stored with a
+// legacy encoding. The migration runs in the background, its progress is
+// reported by MigrateDBStatus.
+func (c *ControlClient) MigrateDB(beaconID string) (*control.MigrateDBResponse, error) {
+	metadata := protoCommon.Metadata{NodeVersion: c.version.ToProto(), BeaconID: beaconID}
+	return c.client.MigrateDatabase(ctx.Background(), &control.MigrateDBRequest{Metadata: &metadata})
+}
+
+// MigrateDBStatus returns the status of the last database migration
+func (c *ControlClient) MigrateDBStatus(beaconID string) (*control.MigrateDBResponse, error) {
+	metadata := protoCommon.Metadata{NodeVersion: c.version.ToProto(), BeaconID: beaconID}
+	return c.client.MigrateDatabase(ctx.Background(), &control.MigrateDBRequest{Metadata: &metadata, Status: true})
+}
+
 // controlListenAddr parses the control address as specified, into a dialable / listenable address
 func controlListenAddr(listenAddr string) (network, addr string) {
 	if strings.HasPrefix(listenAddr, "unix://") {
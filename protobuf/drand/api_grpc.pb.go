@@ -31,6 +31,9 @@ type PublicClient interface {
 	ChainInfo(ctx context.Context, in *ChainInfoRequest, opts ...grpc.CallOption) (*ChainInfoPacket, error)
 	// Home is a simple endpoint
 	Home(ctx context.Context, in *HomeRequest, opts ...grpc.CallOption) (*HomeResponse, error)
+	// Contributors returns which nodes' partial signatures formed the beacon of
+	// a round, when this node aggregated it.
+	Contributors(ctx context.Context, in *ContributorsRequest, opts ...grpc.CallOption) (*ContributorsResponse, error)
 }
 
 type publicClient struct {
@@ -100,6 +103,15 @@ func (c *publicClient) Home(ctx context.Context, in *HomeRequest, opts ...grpc.C
 	return out, nil
 }
 
+func (c *publicClient) Contributors(ctx context.Context, in *ContributorsRequest, opts ...grpc.CallOption) (*ContributorsResponse, error) {
+	out := new(ContributorsResponse)
+	err := c.cc.Invoke(ctx, "/drand.Public/Contributors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PublicServer is the server API for Public service.
 // All implementations should embed UnimplementedPublicServer
 // for forward compatibility
@@ -113,6 +125,9 @@ type PublicServer interface {
 	ChainInfo(context.Context, *ChainInfoRequest) (*ChainInfoPacket, error)
 	// Home is a simple endpoint
 	Home(context.Context, *HomeRequest) (*HomeResponse, error)
+	// Contributors returns which nodes' partial signatures formed the beacon of
+	// a round, when this node aggregated it.
+	Contributors(context.Context, *ContributorsRequest) (*ContributorsResponse, error)
 }
 
 // UnimplementedPublicServer should be embedded to have forward compatible implementations.
@@ -131,6 +146,9 @@ func (UnimplementedPublicServer) ChainInfo(context.Context, *ChainInfoRequest) (
 func (UnimplementedPublicServer) Home(context.Context, *HomeRequest) (*HomeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Home not implemented")
 }
+func (UnimplementedPublicServer) Contributors(context.Context, *ContributorsRequest) (*ContributorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Contributors not implemented")
+}
 
 // UnsafePublicServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to PublicServer will
@@ -218,6 +236,24 @@ func _Public_Home_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Public_Contributors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContributorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicServer).Contributors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/drand.Public/Contributors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicServer).Contributors(ctx, req.(*ContributorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Public_ServiceDesc is the grpc.ServiceDesc for Public service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -237,6 +273,10 @@ var Public_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Home",
 			Handler:    _Public_Home_Handler,
 		},
+		{
+			MethodName: "Contributors",
+			Handler:    _Public_Contributors_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
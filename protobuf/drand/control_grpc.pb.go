@@ -52,8 +52,23 @@ type ControlClient interface {
 	StartFollowChain(ctx context.Context, in *StartSyncRequest, opts ...grpc.CallOption) (Control_StartFollowChainClient, error)
 	StartCheckChain(ctx context.Context, in *StartSyncRequest, opts ...grpc.CallOption) (Control_StartCheckChainClient, error)
 	BackupDatabase(ctx context.Context, in *BackupDBRequest, opts ...grpc.CallOption) (*BackupDBResponse, error)
+	// StreamBackup sends a backup of the database of a beacon in chunks, the
+	// last message carries the checksum of the whole backup
+	StreamBackup(ctx context.Context, in *StreamBackupRequest, opts ...grpc.CallOption) (Control_StreamBackupClient, error)
+	// RestoreDatabase replaces the database of a beacon with a backup made by
+	// BackupDatabase, once it is verified against the group of the node
+	RestoreDatabase(ctx context.Context, in *RestoreDBRequest, opts ...grpc.CallOption) (*RestoreDBResponse, error)
+	// MigrateDatabase rewrites the beacons stored with a legacy encoding using
+	// the current one, while the beacon process keeps running
+	MigrateDatabase(ctx context.Context, in *MigrateDBRequest, opts ...grpc.CallOption) (*MigrateDBResponse, error)
 	// RemoteStatus request the status of some remote drand nodes
 	RemoteStatus(ctx context.Context, in *RemoteStatusRequest, opts ...grpc.CallOption) (*RemoteStatusResponse, error)
+	// PauseBeacon stops the node from sending its partial signatures for a
+	// beacon, while it keeps syncing and serving the chain
+	PauseBeacon(ctx context.Context, in *PauseBeaconRequest, opts ...grpc.CallOption) (*PauseBeaconResponse, error)
+	// ResumeBeacon makes a paused node catch up with the chain and send its
+	// partial signatures again
+	ResumeBeacon(ctx context.Context, in *ResumeBeaconRequest, opts ...grpc.CallOption) (*ResumeBeaconResponse, error)
 }
 
 type controlClient struct {
@@ -254,6 +269,56 @@ func (c *controlClient) BackupDatabase(ctx context.Context, in *BackupDBRequest,
 	return out, nil
 }
 
+func (c *controlClient) StreamBackup(ctx context.Context, in *StreamBackupRequest, opts ...grpc.CallOption) (Control_StreamBackupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Control_ServiceDesc.Streams[2], "/drand.Control/StreamBackup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &controlStreamBackupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Control_StreamBackupClient interface {
+	Recv() (*BackupChunk, error)
+	grpc.ClientStream
+}
+
+type controlStreamBackupClient struct {
+	grpc.ClientStream
+}
+
+func (x *controlStreamBackupClient) Recv() (*BackupChunk, error) {
+	m := new(BackupChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *controlClient) RestoreDatabase(ctx context.Context, in *RestoreDBRequest, opts ...grpc.CallOption) (*RestoreDBResponse, error) {
+	out := new(RestoreDBResponse)
+	err := c.cc.Invoke(ctx, "/drand.Control/RestoreDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) MigrateDatabase(ctx context.Context, in *MigrateDBRequest, opts ...grpc.CallOption) (*MigrateDBResponse, error) {
+	out := new(MigrateDBResponse)
+	err := c.cc.Invoke(ctx, "/drand.Control/MigrateDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controlClient) RemoteStatus(ctx context.Context, in *RemoteStatusRequest, opts ...grpc.CallOption) (*RemoteStatusResponse, error) {
 	out := new(RemoteStatusResponse)
 	err := c.cc.Invoke(ctx, "/drand.Control/RemoteStatus", in, out, opts...)
@@ -263,6 +328,24 @@ func (c *controlClient) RemoteStatus(ctx context.Context, in *RemoteStatusReques
 	return out, nil
 }
 
+func (c *controlClient) PauseBeacon(ctx context.Context, in *PauseBeaconRequest, opts ...grpc.CallOption) (*PauseBeaconResponse, error) {
+	out := new(PauseBeaconResponse)
+	err := c.cc.Invoke(ctx, "/drand.Control/PauseBeacon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) ResumeBeacon(ctx context.Context, in *ResumeBeaconRequest, opts ...grpc.CallOption) (*ResumeBeaconResponse, error) {
+	out := new(ResumeBeaconResponse)
+	err := c.cc.Invoke(ctx, "/drand.Control/ResumeBeacon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ControlServer is the server API for Control service.
 // All implementations should embed UnimplementedControlServer
 // for forward compatibility
@@ -297,8 +380,23 @@ type ControlServer interface {
 	StartFollowChain(*StartSyncRequest, Control_StartFollowChainServer) error
 	StartCheckChain(*StartSyncRequest, Control_StartCheckChainServer) error
 	BackupDatabase(context.Context, *BackupDBRequest) (*BackupDBResponse, error)
+	// StreamBackup sends a backup of the database of a beacon in chunks, the
+	// last message carries the checksum of the whole backup
+	StreamBackup(*StreamBackupRequest, Control_StreamBackupServer) error
+	// RestoreDatabase replaces the database of a beacon with a backup made by
+	// BackupDatabase, once it is verified against the group of the node
+	RestoreDatabase(context.Context, *RestoreDBRequest) (*RestoreDBResponse, error)
+	// MigrateDatabase rewrites the beacons stored with a legacy encoding using
+	// the current one, while the beacon process keeps running
+	MigrateDatabase(context.Context, *MigrateDBRequest) (*MigrateDBResponse, error)
 	// RemoteStatus request the status of some remote drand nodes
 	RemoteStatus(context.Context, *RemoteStatusRequest) (*RemoteStatusResponse, error)
+	// PauseBeacon stops the node from sending its partial signatures for a
+	// beacon, while it keeps syncing and serving the chain
+	PauseBeacon(context.Context, *PauseBeaconRequest) (*PauseBeaconResponse, error)
+	// ResumeBeacon makes a paused node catch up with the chain and send its
+	// partial signatures again
+	ResumeBeacon(context.Context, *ResumeBeaconRequest) (*ResumeBeaconResponse, error)
 }
 
 // UnimplementedControlServer should be embedded to have forward compatible implementations.
@@ -353,9 +451,24 @@ func (UnimplementedControlServer) StartCheckChain(*StartSyncRequest, Control_Sta
 func (UnimplementedControlServer) BackupDatabase(context.Context, *BackupDBRequest) (*BackupDBResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BackupDatabase not implemented")
 }
+func (UnimplementedControlServer) StreamBackup(*StreamBackupRequest, Control_StreamBackupServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBackup not implemented")
+}
+func (UnimplementedControlServer) RestoreDatabase(context.Context, *RestoreDBRequest) (*RestoreDBResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreDatabase not implemented")
+}
+func (UnimplementedControlServer) MigrateDatabase(context.Context, *MigrateDBRequest) (*MigrateDBResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateDatabase not implemented")
+}
 func (UnimplementedControlServer) RemoteStatus(context.Context, *RemoteStatusRequest) (*RemoteStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoteStatus not implemented")
 }
+func (UnimplementedControlServer) PauseBeacon(context.Context, *PauseBeaconRequest) (*PauseBeaconResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseBeacon not implemented")
+}
+func (UnimplementedControlServer) ResumeBeacon(context.Context, *ResumeBeaconRequest) (*ResumeBeaconResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeBeacon not implemented")
+}
 
 // UnsafeControlServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ControlServer will
@@ -662,6 +775,63 @@ func _Control_BackupDatabase_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Control_StreamBackup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamBackupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ControlServer).StreamBackup(m, &controlStreamBackupServer{stream})
+}
+
+type Control_StreamBackupServer interface {
+	Send(*BackupChunk) error
+	grpc.ServerStream
+}
+
+type controlStreamBackupServer struct {
+	grpc.ServerStream
+}
+
+func (x *controlStreamBackupServer) Send(m *BackupChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Control_RestoreDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreDBRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).RestoreDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/drand.Control/RestoreDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).RestoreDatabase(ctx, req.(*RestoreDBRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_MigrateDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateDBRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).MigrateDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/drand.Control/MigrateDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).MigrateDatabase(ctx, req.(*MigrateDBRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Control_RemoteStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RemoteStatusRequest)
 	if err := dec(in); err != nil {
@@ -680,6 +850,42 @@ func _Control_RemoteStatus_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Control_PauseBeacon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseBeaconRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).PauseBeacon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/drand.Control/PauseBeacon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).PauseBeacon(ctx, req.(*PauseBeaconRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_ResumeBeacon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeBeaconRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).ResumeBeacon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/drand.Control/ResumeBeacon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).ResumeBeacon(ctx, req.(*ResumeBeaconRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Control_ServiceDesc is the grpc.ServiceDesc for Control service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -743,10 +949,26 @@ var Control_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BackupDatabase",
 			Handler:    _Control_BackupDatabase_Handler,
 		},
+		{
+			MethodName: "RestoreDatabase",
+			Handler:    _Control_RestoreDatabase_Handler,
+		},
+		{
+			MethodName: "MigrateDatabase",
+			Handler:    _Control_MigrateDatabase_Handler,
+		},
 		{
 			MethodName: "RemoteStatus",
 			Handler:    _Control_RemoteStatus_Handler,
 		},
+		{
+			MethodName: "PauseBeacon",
+			Handler:    _Control_PauseBeacon_Handler,
+		},
+		{
+			MethodName: "ResumeBeacon",
+			Handler:    _Control_ResumeBeacon_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -759,6 +981,11 @@ var Control_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Control_StartCheckChain_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamBackup",
+			Handler:       _Control_StreamBackup_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "drand/control.proto",
 }
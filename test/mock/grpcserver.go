@@ -1,11 +1,9 @@
 package mock
 
 import (
-	"bytes"
 	"context"
 	"crypto/rand"
 	"crypto/sha256"
-	"encoding/binary"
 	"encoding/hex"
 	"fmt"
 	"sync"
@@ -137,26 +135,21 @@ func (s *Server) EmitRand(closeStream bool) {
 
 func testValid(d *Data) {
 	pub := d.Public
-	pubPoint := key.KeyGroup.Point()
+	c := key.CryptoFor(d.Scheme)
+	pubPoint := c.KeyGroup.Point()
 	if err := pubPoint.UnmarshalBinary(pub); err != nil {
 		panic(err)
 	}
 	sig := decodeHex(d.Signature)
 
-	var msg, invMsg []byte
-	if !d.Scheme.DecouplePrevSig {
-		prev := decodeHex(d.PreviousSignature)
-		msg = sha256Hash(append(prev[:], roundToBytes(d.Round)...))
-		invMsg = sha256Hash(append(prev[:], roundToBytes(d.Round-1)...))
-	} else {
-		msg = sha256Hash(roundToBytes(d.Round))
-		invMsg = sha256Hash(roundToBytes(d.Round - 1))
-	}
+	prev := decodeHex(d.PreviousSignature)
+	msg := c.Digest(uint64(d.Round), prev)
+	invMsg := c.Digest(uint64(d.Round-1), prev)
 
-	if err := key.Scheme.VerifyRecovered(pubPoint, msg, sig); err != nil {
+	if err := c.ThresholdScheme.VerifyRecovered(pubPoint, msg, sig); err != nil {
 		panic(err)
 	}
-	if err := key.Scheme.VerifyRecovered(pubPoint, invMsg, sig); err == nil {
+	if err := c.ThresholdScheme.VerifyRecovered(pubPoint, invMsg, sig); err == nil {
 		panic("should be invalid signature")
 	}
 	//fmt.Println("valid signature")
@@ -186,8 +179,9 @@ type Data struct {
 }
 
 func generateMockData(sch scheme.Scheme) *Data {
-	secret := key.KeyGroup.Scalar().Pick(random.New())
-	public := key.KeyGroup.Point().Mul(secret, nil)
+	c := key.CryptoFor(sch)
+	secret := c.KeyGroup.Scalar().Pick(random.New())
+	public := c.KeyGroup.Point().Mul(secret, nil)
 	var previous [32]byte
 	if _, err := rand.Reader.Read(previous[:]); err != nil {
 		panic(err)
@@ -195,15 +189,10 @@ func generateMockData(sch scheme.Scheme) *Data {
 	round := 1969
 	prevRound := uint64(1968)
 
-	var msg []byte
-	if !sch.DecouplePrevSig {
-		msg = sha256Hash(append(previous[:], roundToBytes(round)...))
-	} else {
-		msg = sha256Hash(roundToBytes(round))
-	}
+	msg := c.Digest(uint64(round), previous[:])
 
 	sshare := share.PriShare{I: 0, V: secret}
-	tsig, err := key.Scheme.Sign(&sshare, msg)
+	tsig, err := c.ThresholdScheme.Sign(&sshare, msg)
 	if err != nil {
 		panic(err)
 	}
@@ -230,15 +219,11 @@ func generateMockData(sch scheme.Scheme) *Data {
 func nextMockData(d *Data) *Data {
 	previous := decodeHex(d.PreviousSignature)
 
-	var msg []byte
-	if !d.Scheme.DecouplePrevSig {
-		msg = sha256Hash(append(previous[:], roundToBytes(d.Round+1)...))
-	} else {
-		msg = sha256Hash(roundToBytes(d.Round + 1))
-	}
+	c := key.CryptoFor(d.Scheme)
+	msg := c.Digest(uint64(d.Round+1), previous)
 
 	sshare := share.PriShare{I: 0, V: d.secret}
-	tsig, err := key.Scheme.Sign(&sshare, msg)
+	tsig, err := c.ThresholdScheme.Sign(&sshare, msg)
 	if err != nil {
 		panic(err)
 	}
@@ -293,15 +278,6 @@ func sha256Hash(in []byte) []byte {
 	return h.Sum(nil)
 }
 
-func roundToBytes(r int) []byte {
-	var buff bytes.Buffer
-	err := binary.Write(&buff, binary.BigEndian, uint64(r))
-	if err != nil {
-		return nil
-	}
-	return buff.Bytes()
-}
-
 // NewMockBeacon provides a random beacon and the chain it validates against
 func NewMockBeacon(sch scheme.Scheme) (*drand.ChainInfoPacket, *drand.PublicRandResponse) {
 	d := generateMockData(sch)
@@ -36,6 +36,11 @@ func (s *EmptyServer) Home(context.Context, *drand.HomeRequest) (*drand.HomeResp
 	return nil, nil
 }
 
+// Contributors is an empty implementation
+func (s *EmptyServer) Contributors(context.Context, *drand.ContributorsRequest) (*drand.ContributorsResponse, error) {
+	return nil, nil
+}
+
 // SignalDKGParticipant is an empty implementation
 func (s *EmptyServer) SignalDKGParticipant(context.Context, *drand.SignalDKGPacket) (*drand.Empty, error) {
 	return nil, nil
@@ -146,6 +151,31 @@ func (s *EmptyServer) BackupDatabase(context.Context, *drand.BackupDBRequest) (*
 	return nil, nil
 }
 
+// StreamBackup is an empty implementation
+func (s *EmptyServer) StreamBackup(*drand.StreamBackupRequest, drand.Control_StreamBackupServer) error {
+	return nil
+}
+
+// RestoreDatabase is an empty implementation
+func (s *EmptyServer) RestoreDatabase(context.Context, *drand.RestoreDBRequest) (*drand.RestoreDBResponse, error) {
+	return nil, nil
+}
+
+// MigrateDatabase is an empty implementation
+func (s *EmptyServer) MigrateDatabase(context.Context, *drand.MigrateDBRequest) (*drand.MigrateDBResponse, error) {
+	return nil, nil
+}
+
+// PauseBeacon is an empty implementation
+func (s *EmptyServer) PauseBeacon(context.Context, *drand.PauseBeaconRequest) (*drand.PauseBeaconResponse, error) {
+	return nil, nil
+}
+
+// ResumeBeacon is an empty implementation
+func (s *EmptyServer) ResumeBeacon(context.Context, *drand.ResumeBeaconRequest) (*drand.ResumeBeaconResponse, error) {
+	return nil, nil
+}
+
 // Shutdown is an empty implementation
 func (s *EmptyServer) NodeVersionValidator(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (response interface{}, err error) {
 	return handler(ctx, req)
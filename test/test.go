@@ -117,10 +117,16 @@ func FreePort() string {
 
 // GenerateIDs returns n keys with random port localhost addresses
 func GenerateIDs(n int) []*key.Pair {
+	return GenerateIDsWithScheme(n, scheme.Scheme{})
+}
+
+// GenerateIDsWithScheme returns n keys of the given scheme with random port
+// localhost addresses
+func GenerateIDsWithScheme(n int, sch scheme.Scheme) []*key.Pair {
 	keys := make([]*key.Pair, n)
 	addrs := Addresses(n)
 	for i := range addrs {
-		priv := key.NewKeyPair(addrs[i])
+		priv := key.NewKeyPairWithScheme(addrs[i], sch)
 		keys[i] = priv
 	}
 	return keys
@@ -129,11 +135,11 @@ func GenerateIDs(n int) []*key.Pair {
 // BatchIdentities generates n insecure identities
 func BatchIdentities(n int, sch scheme.Scheme, beaconID string) ([]*key.Pair, *key.Group) {
 	beaconID = commonutils.GetCanonicalBeaconID(beaconID)
-	privs := GenerateIDs(n)
+	privs := GenerateIDsWithScheme(n, sch)
 	thr := key.MinimumT(n)
 	var dpub []kyber.Point
 	for i := 0; i < thr; i++ {
-		dpub = append(dpub, key.KeyGroup.Point().Pick(random.New()))
+		dpub = append(dpub, key.CryptoFor(sch).KeyGroup.Point().Pick(random.New()))
 	}
 
 	dp := &key.DistPublic{Coefficients: dpub}
@@ -0,0 +1,124 @@
+package tlock
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/drand/drand/key"
+	"github.com/drand/kyber"
+)
+
+// Tags separating the domains of the hash functions of the encryption scheme
+var (
+	h2Tag = []byte("IBE-H2")
+	h3Tag = []byte("IBE-H3")
+	h4Tag = []byte("IBE-H4")
+)
+
+// ibeCiphertext is a message encrypted to an identity with the CCA secure
+// identity based encryption scheme of Boneh and Franklin, FullIdent in
+// https://crypto.stanford.edu/~dabo/pubs/papers/bfibe.pdf
+type ibeCiphertext struct {
+	// U is rP, in the key group
+	U kyber.Point
+	// V is sigma XOR H2(e(master, H1(id))^r)
+	V []byte
+	// W is the message XOR H4(sigma)
+	W []byte
+}
+
+// ibeEncrypt encrypts the message to the identity of the master key. The
+// identity hashes to the signature group the way the messages signed by the
+// scheme do, so the signature of the identity is its private key.
+func ibeEncrypt(c *key.Crypto, master kyber.Point, id, msg []byte) (*ibeCiphertext, error) {
+	if len(msg) > sha256.Size {
+		return nil, errors.New("plaintext too long for the hash function")
+	}
+
+	qid, err := c.HashToSigGroup(id)
+	if err != nil {
+		return nil, err
+	}
+	gid := c.Pair(master, qid)
+
+	sigma := make([]byte, len(msg))
+	if _, err := rand.Read(sigma); err != nil {
+		return nil, fmt.Errorf("reading random sigma: %w", err)
+	}
+	r := h3(c, sigma, msg)
+	u := c.KeyGroup.Point().Mul(r, nil)
+
+	rgid, err := gtToHash(gid.Mul(r, gid), len(msg))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ibeCiphertext{
+		U: u,
+		V: xor(sigma, rgid),
+		W: xor(msg, h4(sigma, len(msg))),
+	}, nil
+}
+
+// ibeDecrypt decrypts the ciphertext with the private key of its identity,
+// i.e. the signature of the identity
+func ibeDecrypt(c *key.Crypto, private kyber.Point, ct *ibeCiphertext) ([]byte, error) {
+	if len(ct.W) > sha256.Size {
+		return nil, errors.New("ciphertext too long for the hash function")
+	}
+	if len(ct.V) != len(ct.W) {
+		return nil, fmt.Errorf("invalid sigma length: expected %d, got %d", len(ct.W), len(ct.V))
+	}
+
+	rgid, err := gtToHash(c.Pair(ct.U, private), len(ct.W))
+	if err != nil {
+		return nil, err
+	}
+	sigma := xor(rgid, ct.V)
+	msg := xor(ct.W, h4(sigma, len(ct.W)))
+
+	r := h3(c, sigma, msg)
+	if !c.KeyGroup.Point().Mul(r, nil).Equal(ct.U) {
+		return nil, errors.New("invalid proof: rP check failed")
+	}
+	return msg, nil
+}
+
+// gtToHash hashes the element of the target group to length bytes
+func gtToHash(gt kyber.Point, length int) ([]byte, error) {
+	buff, err := gt.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("marshaling the target group element: %w", err)
+	}
+	h := sha256.New()
+	_, _ = h.Write(h2Tag)
+	_, _ = h.Write(buff)
+	return h.Sum(nil)[:length], nil
+}
+
+// h3 derives the randomness of the encryption from sigma and the message
+func h3(c *key.Crypto, sigma, msg []byte) kyber.Scalar {
+	h := sha256.New()
+	_, _ = h.Write(h3Tag)
+	_, _ = h.Write(sigma)
+	_, _ = h.Write(msg)
+	return c.KeyGroup.Scalar().Pick(key.Pairing.XOF(h.Sum(nil)))
+}
+
+// h4 hashes sigma to length bytes
+func h4(sigma []byte, length int) []byte {
+	h := sha256.New()
+	_, _ = h.Write(h4Tag)
+	_, _ = h.Write(sigma)
+	return h.Sum(nil)[:length]
+}
+
+func xor(a, b []byte) []byte {
+	out := make([]byte, len(a))
+	for i := range out {
+		out[i] = a[i] ^ b[i]
+	}
+	return out
+}
@@ -0,0 +1,214 @@
+// Package tlock encrypts data to a future round of a drand chain, so that it
+// can only be decrypted once the beacon of that round is published.
+//
+// The data is encrypted with identity based encryption, the identity being the
+// message the nodes sign at that round: with an unchained scheme it only
+// depends on the round, and the beacon signature is the private key of the
+// identity under the distributed key of the chain.
+package tlock
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/drand/drand/chain"
+	"github.com/drand/drand/client"
+	"github.com/drand/drand/key"
+)
+
+// dataKeyLen is the length of the AES key encrypting the data, which is itself
+// encrypted to the round
+const dataKeyLen = 32
+
+// nonceLen is the length of the standard AES-GCM nonce
+const nonceLen = 12
+
+// ErrChainedScheme is returned for chains whose beacons sign the previous
+// signature: the message of a future round can't be known in advance.
+var ErrChainedScheme = errors.New("tlock: timelock encryption requires an unchained scheme")
+
+// ErrTooEarly is returned when decrypting before the round of the ciphertext
+// is published.
+var ErrTooEarly = errors.New("tlock: too early to decrypt")
+
+// ErrWrongChain is returned when decrypting data encrypted to another chain.
+var ErrWrongChain = errors.New("tlock: ciphertext encrypted to another chain")
+
+// Tlock encrypts data to the rounds of a chain and decrypts it with the
+// beacons fetched from the client.
+type Tlock struct {
+	info   *chain.Info
+	client client.Client
+	crypto *key.Crypto
+	hash   []byte
+}
+
+// New returns a Tlock encrypting to the rounds of the chain and fetching the
+// beacons from the client, which should be connected to the same chain.
+func New(info *chain.Info, c client.Client) (*Tlock, error) {
+	if info == nil {
+		return nil, errors.New("tlock: missing chain info")
+	}
+	crypto := key.CryptoFor(info.Scheme)
+	if crypto.Chained {
+		return nil, ErrChainedScheme
+	}
+	return &Tlock{
+		info:   info,
+		client: c,
+		crypto: crypto,
+		hash:   info.Hash(),
+	}, nil
+}
+
+// RoundAt returns the round published at the given time, the first one that
+// can decrypt data once that time has passed.
+func (t *Tlock) RoundAt(tm time.Time) uint64 {
+	return chain.CurrentRound(tm.Unix(), t.info.Period, t.info.GenesisTime)
+}
+
+// TimeOf returns the time the given round is published at.
+func (t *Tlock) TimeOf(round uint64) time.Time {
+	return time.Unix(chain.TimeOfRound(t.info.Period, t.info.GenesisTime, round), 0)
+}
+
+// Encrypt encrypts the data to the given round. The ciphertext is made of the
+// chain hash, the round, the data key encrypted to the round and the data
+// encrypted with AES-GCM under the data key.
+func (t *Tlock) Encrypt(round uint64, data []byte) ([]byte, error) {
+	if round == 0 {
+		return nil, errors.New("tlock: can't encrypt to the genesis round")
+	}
+
+	dataKey := make([]byte, dataKeyLen)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, fmt.Errorf("tlock: generating data key: %w", err)
+	}
+	id := chain.NewVerifier(t.info.Scheme).DigestMessage(round, nil)
+	ct, err := ibeEncrypt(t.crypto, t.info.PublicKey, id, dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("tlock: encrypting data key: %w", err)
+	}
+	u, err := ct.U.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, nonceLen)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("tlock: generating nonce: %w", err)
+	}
+
+	var buff bytes.Buffer
+	_, _ = buff.Write(t.hash)
+	_ = binary.Write(&buff, binary.BigEndian, round)
+	_, _ = buff.Write(u)
+	_, _ = buff.Write(ct.V)
+	_, _ = buff.Write(ct.W)
+	_, _ = buff.Write(nonce)
+	// the header is authenticated along the data
+	header := buff.Bytes()
+	return aead.Seal(append([]byte{}, header...), nonce, data, header), nil
+}
+
+// Round returns the round the ciphertext is encrypted to.
+func (t *Tlock) Round(ciphertext []byte) (uint64, error) {
+	if len(ciphertext) < len(t.hash)+8 {
+		return 0, errors.New("tlock: ciphertext too short")
+	}
+	if !bytes.Equal(ciphertext[:len(t.hash)], t.hash) {
+		return 0, ErrWrongChain
+	}
+	return binary.BigEndian.Uint64(ciphertext[len(t.hash):]), nil
+}
+
+// Decrypt fetches the beacon of the round of the ciphertext and decrypts it.
+// It returns ErrTooEarly if the round isn't published yet.
+func (t *Tlock) Decrypt(ctx context.Context, ciphertext []byte) ([]byte, error) {
+	round, err := t.Round(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	if round > t.RoundAt(time.Now()) {
+		return nil, ErrTooEarly
+	}
+	if t.client == nil {
+		return nil, errors.New("tlock: no client to fetch the beacon from")
+	}
+
+	res, err := t.client.Get(ctx, round)
+	if err != nil {
+		return nil, fmt.Errorf("tlock: fetching beacon %d: %w", round, err)
+	}
+	return t.DecryptWith(res.Signature(), ciphertext)
+}
+
+// DecryptWith decrypts the ciphertext with the signature of the beacon of its
+// round.
+func (t *Tlock) DecryptWith(signature, ciphertext []byte) ([]byte, error) {
+	round, err := t.Round(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	b := chain.Beacon{Round: round, Signature: signature}
+	if err := chain.NewVerifier(t.info.Scheme).VerifyBeacon(b, t.info.PublicKey); err != nil {
+		return nil, fmt.Errorf("tlock: invalid beacon %d: %w", round, err)
+	}
+	sig := t.crypto.SigGroup.Point()
+	if err := sig.UnmarshalBinary(signature); err != nil {
+		return nil, err
+	}
+
+	uLen := t.crypto.KeyGroup.PointLen()
+	headerLen := len(t.hash) + 8 + uLen + 2*dataKeyLen + nonceLen
+	if len(ciphertext) < headerLen {
+		return nil, errors.New("tlock: ciphertext too short")
+	}
+
+	offset := len(t.hash) + 8
+	u := t.crypto.KeyGroup.Point()
+	if err := u.UnmarshalBinary(ciphertext[offset : offset+uLen]); err != nil {
+		return nil, fmt.Errorf("tlock: invalid ciphertext: %w", err)
+	}
+	offset += uLen
+	ct := &ibeCiphertext{
+		U: u,
+		V: ciphertext[offset : offset+dataKeyLen],
+		W: ciphertext[offset+dataKeyLen : offset+2*dataKeyLen],
+	}
+	offset += 2 * dataKeyLen
+
+	dataKey, err := ibeDecrypt(t.crypto, sig, ct)
+	if err != nil {
+		return nil, fmt.Errorf("tlock: decrypting data key: %w", err)
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	nonce := ciphertext[offset:headerLen]
+	data, err := aead.Open(nil, nonce, ciphertext[headerLen:], ciphertext[:headerLen])
+	if err != nil {
+		return nil, fmt.Errorf("tlock: decrypting data: %w", err)
+	}
+	return data, nil
+}
+
+func newAEAD(dataKey []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
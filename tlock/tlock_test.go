@@ -0,0 +1,106 @@
+package tlock
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/chain"
+	"github.com/drand/drand/client"
+	"github.com/drand/drand/client/test/result/mock"
+	"github.com/drand/drand/common/scheme"
+)
+
+// resultsClient serves the given results by round
+type resultsClient struct {
+	info    *chain.Info
+	results []mock.Result
+}
+
+func (c *resultsClient) Get(_ context.Context, round uint64) (client.Result, error) {
+	for i := range c.results {
+		if c.results[i].Round() == round {
+			return &c.results[i], nil
+		}
+	}
+	return nil, errors.New("no result available")
+}
+
+func (c *resultsClient) Watch(context.Context) <-chan client.Result {
+	return nil
+}
+
+func (c *resultsClient) Info(context.Context) (*chain.Info, error) {
+	return c.info, nil
+}
+
+func (c *resultsClient) RoundAt(t time.Time) uint64 {
+	return chain.CurrentRound(t.Unix(), c.info.Period, c.info.GenesisTime)
+}
+
+func (c *resultsClient) Close() error {
+	return nil
+}
+
+var _ client.Client = (*resultsClient)(nil)
+
+func TestTlockRefusesChainedScheme(t *testing.T) {
+	sch, _ := scheme.GetSchemeByID(scheme.DefaultSchemeID)
+	info, _ := mock.VerifiableResults(1, sch)
+	_, err := New(info, nil)
+	require.ErrorIs(t, err, ErrChainedScheme)
+}
+
+func TestTlock(t *testing.T) {
+	for _, id := range []string{scheme.UnchainedSchemeID, scheme.ShortSigSchemeID, scheme.RFC9380SchemeID} {
+		t.Run(id, func(t *testing.T) {
+			sch, _ := scheme.GetSchemeByID(id)
+			testTlock(t, sch)
+		})
+	}
+}
+
+func testTlock(t *testing.T, sch scheme.Scheme) {
+	ctx := context.Background()
+	info, results := mock.VerifiableResults(3, sch)
+	tl, err := New(info, &resultsClient{info: info, results: results})
+	require.NoError(t, err)
+
+	data := []byte("sealed bid: 42")
+	ct, err := tl.Encrypt(2, data)
+	require.NoError(t, err)
+	round, err := tl.Round(ct)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), round)
+
+	out, err := tl.Decrypt(ctx, ct)
+	require.NoError(t, err)
+	require.Equal(t, data, out)
+
+	// the beacon of another round doesn't decrypt
+	_, err = tl.DecryptWith(results[0].Signature(), ct)
+	require.Error(t, err)
+
+	// a tampered ciphertext doesn't decrypt
+	tampered := append([]byte{}, ct...)
+	tampered[len(tampered)-1] ^= 1
+	_, err = tl.Decrypt(ctx, tampered)
+	require.Error(t, err)
+
+	// a round in the future can't be decrypted yet
+	future := tl.RoundAt(time.Now().Add(time.Hour))
+	ct, err = tl.Encrypt(future, data)
+	require.NoError(t, err)
+	_, err = tl.Decrypt(ctx, ct)
+	require.ErrorIs(t, err, ErrTooEarly)
+
+	// a ciphertext of another chain is refused
+	otherInfo, _ := mock.VerifiableResults(1, sch)
+	other, err := New(otherInfo, nil)
+	require.NoError(t, err)
+	_, err = other.Decrypt(ctx, ct)
+	require.ErrorIs(t, err, ErrWrongChain)
+}